@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+
+	"my-gin-app/database"
+	"my-gin-app/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IndexReporter diimplementasikan oleh repository yang punya definisi index
+type IndexReporter interface {
+	IndexStatus(ctx context.Context) ([]database.IndexStatus, error)
+}
+
+// APIKeyHeader adalah header yang harus berisi X_API_KEY untuk route admin
+const APIKeyHeader = "X-API-Key"
+
+type Handler struct {
+	Indexes map[string]IndexReporter
+	// APIKey wajib dikirim di APIKeyHeader; kosong berarti route admin dinonaktifkan
+	APIKey string
+}
+
+func NewHandler(indexes map[string]IndexReporter, apiKey string) *Handler {
+	return &Handler{
+		Indexes: indexes,
+		APIKey:  apiKey,
+	}
+}
+
+// authorized menolak request tanpa API key yang benar; route admin membuka nama collection dan index
+func (h *Handler) authorized(c *gin.Context) bool {
+	if h.APIKey == "" {
+		response.Fail(c, http.StatusForbidden, "Admin API is disabled")
+		return false
+	}
+	if subtle.ConstantTimeCompare([]byte(c.GetHeader(APIKeyHeader)), []byte(h.APIKey)) != 1 {
+		response.Fail(c, http.StatusUnauthorized, "Invalid API key")
+		return false
+	}
+	return true
+}
+
+// ListIndexes handler untuk menampilkan status index setiap resource
+func (h *Handler) ListIndexes(c *gin.Context) {
+	if !h.authorized(c) {
+		return
+	}
+
+	result := map[string][]database.IndexStatus{}
+	healthy := true
+	for resource, reporter := range h.Indexes {
+		statuses, err := reporter.IndexStatus(c.Request.Context())
+		if err != nil {
+			// detail driver hanya masuk log server, bukan respons
+			_ = c.Error(fmt.Errorf("index status %s: %w", resource, err))
+			response.Fail(c, http.StatusInternalServerError, "Failed to read index status")
+			return
+		}
+		for _, s := range statuses {
+			if s.State != database.IndexOK && s.State != database.IndexExtra {
+				healthy = false
+			}
+		}
+		result[resource] = statuses
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{
+		Message: "Success retrieved index status",
+		Healthy: response.Bool(healthy),
+	})
+}
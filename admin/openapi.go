@@ -0,0 +1,19 @@
+package admin
+
+import (
+	"net/http"
+
+	"my-gin-app/database"
+	"my-gin-app/openapi"
+)
+
+// Operations mendokumentasikan route admin untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/admin/indexes", Tag: "admin",
+			Summary: "Index status per resource (requires X-API-Key)",
+			Result:  map[string][]database.IndexStatus{},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// StatsReporter diimplementasikan oleh setiap Cache, dipakai untuk metrik hit/miss
+type StatsReporter interface {
+	Stats() (hits, misses int64)
+}
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// Cache adalah cache in-memory dengan TTL yang sama untuk semua key, beserta penghitung hit/miss
+type Cache[V any] struct {
+	mu     sync.RWMutex
+	ttl    time.Duration
+	items  map[string]entry[V]
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+func New[V any](ttl time.Duration) *Cache[V] {
+	return &Cache[V]{
+		ttl:   ttl,
+		items: map[string]entry[V]{},
+	}
+}
+
+// Get mengembalikan nilai yang belum kedaluwarsa
+func (c *Cache[V]) Get(key string) (V, bool) {
+	c.mu.RLock()
+	e, ok := c.items[key]
+	c.mu.RUnlock()
+
+	if !ok || time.Now().After(e.expiresAt) {
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+	c.hits.Add(1)
+	return e.value, true
+}
+
+// Set menyimpan nilai; TTL nol atau negatif berarti cache dimatikan
+func (c *Cache[V]) Set(key string, value V) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range c.items {
+		if now.After(e.expiresAt) {
+			delete(c.items, k)
+		}
+	}
+	c.items[key] = entry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+// Clear mengosongkan cache
+func (c *Cache[V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = map[string]entry[V]{}
+}
+
+// Stats mengembalikan jumlah hit dan miss sejak cache dibuat
+func (c *Cache[V]) Stats() (hits, misses int64) {
+	return c.hits.Load(), c.misses.Load()
+}
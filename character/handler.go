@@ -1,10 +1,15 @@
 package character
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"my-gin-app/fields"
 	"my-gin-app/models"
+	"my-gin-app/response"
+	"my-gin-app/search"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gosimple/slug"
@@ -24,35 +29,53 @@ func NewHandler(service Service) *Handler {
 func (h *Handler) CreateUser(c *gin.Context) {
 	var character models.Character
 	if err := c.ShouldBindJSON(&character); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	if err := h.Service.CreateCharacter(&character); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.Service.CreateCharacter(c.Request.Context(), &character); err != nil {
+		if err.Error() == "character already exists" {
+			response.Fail(c, http.StatusConflict, "Character Already Exists")
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"result": character})
+	result, err := present(c, character, nil)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.OK(c, http.StatusCreated, result, nil)
 }
 
 // ReadUser handler untuk membaca karakter berdasarkan slug
 func (h *Handler) ReadUser(c *gin.Context) {
 	slugParam := c.Param("slug")
-	character, err := h.Service.GetCharacterBySlug(slugParam)
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	character, err := h.Service.GetCharacterBySlug(c.Request.Context(), slugParam, selected...)
 	if err != nil {
 		if err.Error() == "character not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Character Not Found"})
+			response.Fail(c, http.StatusNotFound, "Character Not Found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Success retrieved data",
-		"result":  character,
-	})
+	result, err := present(c, character, selected)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{Message: "Success retrieved data"})
 }
 
 // UpdateUser handler untuk memperbarui karakter berdasarkan slug
@@ -60,47 +83,52 @@ func (h *Handler) UpdateUser(c *gin.Context) {
 	slugParam := c.Param("slug")
 	var updatedData models.Character
 	if err := c.ShouldBindJSON(&updatedData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	if err := h.Service.UpdateCharacter(slugParam, &updatedData); err != nil {
+	if err := h.Service.UpdateCharacter(c.Request.Context(), slugParam, &updatedData); err != nil {
 		if err.Error() == "character not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Character Not Found"})
+			response.Fail(c, http.StatusNotFound, "Character Not Found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	updatedCharacter, err := h.Service.GetCharacterBySlug(slug.Make(updatedData.Name))
+	updatedCharacter, err := h.Service.GetCharacterBySlug(c.Request.Context(), slug.Make(updatedData.Name))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve updated character"})
+		response.Fail(c, http.StatusInternalServerError, "Failed to retrieve updated character")
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"status":  "success",
-		"message": "Character updated",
-		"result":  updatedCharacter,
+	result, err := present(c, updatedCharacter, nil)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{
+		Message: "Character updated",
+		Legacy:  gin.H{"status": "success"},
 	})
 }
 
 // DeleteUser handler untuk menghapus karakter berdasarkan slug
 func (h *Handler) DeleteUser(c *gin.Context) {
 	slugParam := c.Param("slug")
-	if err := h.Service.DeleteCharacter(slugParam); err != nil {
+	if err := h.Service.DeleteCharacter(c.Request.Context(), slugParam); err != nil {
 		if err.Error() == "character not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Character Not Found"})
+			response.Fail(c, http.StatusNotFound, "Character Not Found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
-	c.JSON(http.StatusNoContent, gin.H{"message": "Character deleted"})
+	c.Status(http.StatusNoContent)
 }
 
-// IndexUser handler untuk mengambil daftar karakter dengan pagination
+// IndexUser handler untuk mengambil daftar karakter dengan pagination, filter dan facet
 func (h *Handler) IndexUser(c *gin.Context) {
 	pageStr := c.Query("page")
 	limitStr := c.Query("limit")
@@ -111,7 +139,7 @@ func (h *Handler) IndexUser(c *gin.Context) {
 	if pageStr != "" {
 		page, err = strconv.Atoi(pageStr)
 		if err != nil || page < 1 {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page number"})
+			response.Fail(c, http.StatusBadRequest, "Invalid page number")
 			return
 		}
 	}
@@ -119,55 +147,223 @@ func (h *Handler) IndexUser(c *gin.Context) {
 	if limitStr != "" {
 		limit, err = strconv.Atoi(limitStr)
 		if err != nil || limit < 1 {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit number"})
+			response.Fail(c, http.StatusBadRequest, "Invalid limit number")
 			return
 		}
 	}
 
-	characters, count, err := h.Service.ListCharacters(page, limit)
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	filters := queryFilters(c)
+	characters, count, err := h.Service.ListCharacters(c.Request.Context(), page, limit, filters, selected...)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	result, err := present(c, characters, selected)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	facets, ok := h.facets(c, models.FacetScope{Filters: filters})
+	if !ok {
 		return
 	}
 
+	meta := &response.Meta{
+		Message: "Success retrieved all data",
+		Facets:  facets,
+	}
 	if page > 0 && limit > 0 {
-		totalPages := (count + int64(limit) - 1) / int64(limit)
-		c.JSON(http.StatusOK, gin.H{
-			"message":    "Success retrieved data",
-			"result":     characters,
-			"page":       page,
-			"limit":      limit,
-			"totalPages": totalPages,
-			"totalItems": count,
-		})
-	} else {
-		c.JSON(http.StatusOK, gin.H{
-			"message": "Success retrieved all data",
-			"result":  characters,
-		})
+		meta.Message = "Success retrieved data"
+		meta.Pagination = &response.Pagination{
+			Page:       page,
+			Limit:      limit,
+			TotalPages: (count + int64(limit) - 1) / int64(limit),
+			TotalItems: count,
+		}
 	}
+	response.List(c, result, meta)
 }
 
-// SearchCharacter handler untuk mencari karakter berdasarkan nama
+// SearchCharacter handler untuk mencari karakter berdasarkan nama, atau full-text lewat parameter q
 func (h *Handler) SearchCharacter(c *gin.Context) {
+	if q := c.Query("q"); q != "" {
+		h.textSearch(c, q)
+		return
+	}
+
 	nameQuery := c.Query("name")
 	if nameQuery == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Name query parameter is required"})
+		response.Fail(c, http.StatusBadRequest, "Name or q query parameter is required")
+		return
+	}
+
+	page, limit, ok := queryPage(c)
+	if !ok {
 		return
 	}
 
-	characters, err := h.Service.SearchCharacters(nameQuery)
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	characters, err := h.Service.SearchCharacters(c.Request.Context(), nameQuery, selected...)
 	if err != nil {
 		if err.Error() == "no characters found" {
-			c.JSON(http.StatusNotFound, gin.H{"message": "No characters found"})
+			var didYouMean string
+			var noMatch *search.NoMatchError
+			if errors.As(err, &noMatch) {
+				didYouMean = noMatch.DidYouMean
+			}
+			response.NoMatch(c, "No characters found", didYouMean)
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Found characters",
-		"result":  characters,
-	})
+	slugs := make([]string, len(characters))
+	for i, item := range characters {
+		slugs[i] = item.Slug
+	}
+	facets, ok := h.facets(c, models.FacetScope{Slugs: slugs})
+	if !ok {
+		return
+	}
+
+	meta := &response.Meta{Facets: facets}
+	if page > 0 && limit > 0 {
+		characters, meta.Pagination = response.Paginate(characters, page, limit)
+	}
+
+	result, err := present(c, characters, selected)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	meta.Message = "Found characters"
+	response.List(c, result, meta)
+}
+
+// textSearch menjalankan full-text search dengan skor relevansi dan highlight
+func (h *Handler) textSearch(c *gin.Context, q string) {
+	page, limit, ok := queryPage(c)
+	if !ok {
+		return
+	}
+	// dengan page, limit adalah ukuran halaman; tanpa page, limit adalah jumlah hit maksimum
+	maxHits := limit
+	if page > 0 && limit > 0 {
+		maxHits = 0
+	}
+
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	hits, err := h.Service.TextSearchCharacters(c.Request.Context(), q, maxHits, selected...)
+	if err != nil {
+		if err.Error() == "no characters found" {
+			response.NoMatch(c, "No characters found", "")
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	facets, ok := h.facets(c, models.FacetScope{Text: q})
+	if !ok {
+		return
+	}
+
+	meta := &response.Meta{Message: "Found characters", Facets: facets}
+	if page > 0 && limit > 0 {
+		hits, meta.Pagination = response.Paginate(hits, page, limit)
+	}
+
+	result, err := present(c, hits, selected, "score", "highlights")
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.List(c, result, meta)
+}
+
+// facets menghitung facet yang diminta lewat parameter facets=clan,status; nil jika tidak diminta.
+// ok=false berarti respons error sudah ditulis.
+func (h *Handler) facets(c *gin.Context, scope models.FacetScope) (models.Facets, bool) {
+	facetsStr := c.Query("facets")
+	if facetsStr == "" {
+		return nil, true
+	}
+
+	var names []string
+	for _, name := range strings.Split(facetsStr, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	facets, err := h.Service.CharacterFacets(c.Request.Context(), names, scope)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid facet") {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return nil, false
+	}
+	return facets, true
+}
+
+// queryFilters mengambil filter exact-match dari query string, misal ?clan=Uchiha+Clan
+func queryFilters(c *gin.Context) map[string]string {
+	filters := map[string]string{}
+	for name := range FacetFields {
+		if value := c.Query(name); value != "" {
+			filters[name] = value
+		}
+	}
+	return filters
+}
+
+// queryFields membaca sparse fieldset ?fields=name,slug,personal.clan; ok=false berarti respons error sudah ditulis
+func queryFields(c *gin.Context) ([]string, bool) {
+	selected, err := fields.Parse(c.Query("fields"), models.Character{})
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return selected, true
+}
+
+// queryPage membaca ?page=&limit= untuk pencarian; halaman hanya dipakai jika keduanya diisi
+func queryPage(c *gin.Context) (page, limit int, ok bool) {
+	if pageStr := c.Query("page"); pageStr != "" {
+		var err error
+		page, err = strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			response.Fail(c, http.StatusBadRequest, "Invalid page number")
+			return 0, 0, false
+		}
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			response.Fail(c, http.StatusBadRequest, "Invalid limit number")
+			return 0, 0, false
+		}
+	}
+	return page, limit, true
 }
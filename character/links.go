@@ -0,0 +1,32 @@
+package character
+
+import (
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/fields"
+	"my-gin-app/response"
+)
+
+// Links membuat _links HAL untuk satu karakter berdasarkan slug-nya. tailedBeasts adalah kebalikan link
+// jinchuriki di tailed beast: daftar tailed beast yang difilter dengan slug karakter ini sebagai host.
+func Links(c *gin.Context, slugValue string) response.Links {
+	return response.Links{
+		"self":         {Href: response.URL(c, "/character/"+slugValue, nil)},
+		"collection":   {Href: response.URL(c, "/character", nil)},
+		"tailedBeasts": {Href: response.URL(c, "/tailedbeast", url.Values{"jinchuriki": {slugValue}})},
+	}
+}
+
+// present menambahkan _links lalu memangkas hasil ke sparse fieldset; slug selalu ada karena ikut di projection
+func present(c *gin.Context, v interface{}, selected []string, keep ...string) (interface{}, error) {
+	linked, err := response.WithLinks(c, v, func(doc map[string]interface{}) response.Links {
+		slugValue, _ := doc["slug"].(string)
+		return Links(c, slugValue)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields.Select(linked, selected, append(keep, "_links")...)
+}
@@ -0,0 +1,80 @@
+package character
+
+import (
+	"net/http"
+	"sort"
+
+	"my-gin-app/models"
+	"my-gin-app/openapi"
+)
+
+const tag = "character"
+
+// Operations mendokumentasikan route karakter untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	fieldsParam := openapi.Param{Name: "fields", Description: "Comma separated sparse fieldset, e.g. name,personal.clan"}
+	facetsParam := openapi.Param{Name: "facets", Description: "Comma separated facet counts to include"}
+
+	index := []openapi.Param{
+		{Name: "page", Type: "integer", Description: "Page number, requires limit"},
+		{Name: "limit", Type: "integer", Description: "Page size, requires page"},
+		fieldsParam,
+		facetsParam,
+	}
+	index = append(index, filterParams()...)
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/character", Tag: tag,
+			Summary: "List characters with optional pagination, filters and facets",
+			Query:   index, Result: []models.Character{}, Linked: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/character/search", Tag: tag,
+			Summary: "Search characters by fuzzy name or full-text query",
+			Query: []openapi.Param{
+				{Name: "name", Description: "Fuzzy name match, required when q is empty"},
+				{Name: "q", Description: "Full-text query with relevance score and highlights"},
+				{Name: "page", Type: "integer", Description: "Page number, requires limit"},
+				{Name: "limit", Type: "integer", Description: "Page size with page, otherwise maximum full-text hits"},
+				fieldsParam,
+				facetsParam,
+			},
+			Result: []models.CharacterHit{}, Linked: true,
+		},
+		{
+			Method: http.MethodPost, Path: "/character", Tag: tag,
+			Summary: "Create a character",
+			Body:    models.Character{}, Status: http.StatusCreated, Result: models.Character{}, Linked: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/character/:slug", Tag: tag,
+			Summary: "Get a character by slug",
+			Query:   []openapi.Param{fieldsParam}, Result: models.Character{}, Linked: true,
+		},
+		{
+			Method: http.MethodPut, Path: "/character/:slug", Tag: tag,
+			Summary: "Update a character by slug",
+			Body:    models.Character{}, Result: models.Character{}, Linked: true,
+		},
+		{
+			Method: http.MethodDelete, Path: "/character/:slug", Tag: tag,
+			Summary: "Delete a character by slug",
+			Status:  http.StatusNoContent,
+		},
+	}
+}
+
+func filterParams() []openapi.Param {
+	names := make([]string, 0, len(FacetFields))
+	for name := range FacetFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]openapi.Param, len(names))
+	for i, name := range names {
+		params[i] = openapi.Param{Name: name, Description: "Exact-match filter"}
+	}
+	return params
+}
@@ -3,21 +3,62 @@ package character
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"my-gin-app/database"
+	"my-gin-app/fields"
 	"my-gin-app/models"
 )
 
 type Repository interface {
-	Create(character *models.Character) error
-	FindBySlug(slug string) (*models.Character, error)
-	UpdateBySlug(slug string, update bson.M) error
-	DeleteBySlug(slug string) error
-	ListCharacters(skip int64, limit int64) ([]models.Character, error)
-	CountCharacters() (int64, error)
+	Create(ctx context.Context, character *models.Character) error
+	FindBySlug(ctx context.Context, slug string, fields ...string) (*models.Character, error)
+	UpdateBySlug(ctx context.Context, slug string, update bson.M) error
+	DeleteBySlug(ctx context.Context, slug string) error
+	ListCharacters(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) ([]models.Character, error)
+	CountCharacters(ctx context.Context, filter bson.M) (int64, error)
+	Facets(ctx context.Context, filter bson.M, facets []string) (models.Facets, error)
+	Stats(ctx context.Context, top int64, groupBy string) (*models.CharacterStats, error)
+	TextSearch(ctx context.Context, query string, limit int64, fields ...string) ([]models.CharacterHit, error)
+	EnsureIndexes(ctx context.Context) ([]database.IndexStatus, error)
+	IndexStatus(ctx context.Context) ([]database.IndexStatus, error)
+}
+
+// Indexes adalah index yang dibutuhkan query karakter; direkonsiliasi saat startup
+var Indexes = []database.Index{
+	{Name: "slug_unique", Keys: bson.D{{Key: "slug", Value: 1}}, Unique: true},
+	{
+		Name: "character_text",
+		Keys: bson.D{
+			{Key: "name", Value: "text"},
+			{Key: "jutsu", Value: "text"},
+			{Key: "personal.occupation", Value: "text"},
+			{Key: "personal.clan", Value: "text"},
+			{Key: "personal.affiliation", Value: "text"},
+		},
+		Weights: bson.D{
+			{Key: "name", Value: 10},
+			{Key: "jutsu", Value: 5},
+			{Key: "personal.clan", Value: 3},
+			{Key: "personal.affiliation", Value: 2},
+			{Key: "personal.occupation", Value: 1},
+		},
+	},
+	{Name: "clan_name", Keys: bson.D{{Key: "personal.clan", Value: 1}, {Key: "name", Value: 1}}},
+	{Name: "affiliation_name", Keys: bson.D{{Key: "personal.affiliation", Value: 1}, {Key: "name", Value: 1}}},
+	{Name: "ninjarank_name", Keys: bson.D{{Key: "rank.ninjaRank", Value: 1}, {Key: "name", Value: 1}}},
+}
+
+// FacetFields memetakan nama facet/filter publik ke path field di dokumen
+var FacetFields = map[string]string{
+	"clan":           "personal.clan",
+	"affiliation":    "personal.affiliation",
+	"rank.ninjaRank": "rank.ninjaRank",
+	"status":         "personal.status",
 }
 
 type MongoRepository struct {
@@ -30,14 +71,22 @@ func NewRepository(collection *mongo.Collection) Repository {
 	}
 }
 
-func (r *MongoRepository) Create(character *models.Character) error {
-	_, err := r.Collection.InsertOne(context.Background(), character)
+func (r *MongoRepository) Create(ctx context.Context, character *models.Character) error {
+	_, err := r.Collection.InsertOne(ctx, character)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New("character already exists")
+	}
 	return err
 }
 
-func (r *MongoRepository) FindBySlug(slug string) (*models.Character, error) {
+// FindBySlug mengambil satu dokumen; fields (opsional) membatasi field yang diambil dari database
+func (r *MongoRepository) FindBySlug(ctx context.Context, slug string, fields ...string) (*models.Character, error) {
 	var character models.Character
-	err := r.Collection.FindOne(context.Background(), bson.M{"slug": slug}).Decode(&character)
+	findOptions := options.FindOne()
+	if projection := fieldsProjection(fields); projection != nil {
+		findOptions.SetProjection(projection)
+	}
+	err := r.Collection.FindOne(ctx, bson.M{"slug": slug}, findOptions).Decode(&character)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, errors.New("character not found")
@@ -47,30 +96,33 @@ func (r *MongoRepository) FindBySlug(slug string) (*models.Character, error) {
 	return &character, nil
 }
 
-func (r *MongoRepository) UpdateBySlug(slug string, update bson.M) error {
-	_, err := r.Collection.UpdateOne(context.Background(), bson.M{"slug": slug}, bson.M{"$set": update})
+func (r *MongoRepository) UpdateBySlug(ctx context.Context, slug string, update bson.M) error {
+	_, err := r.Collection.UpdateOne(ctx, bson.M{"slug": slug}, bson.M{"$set": update})
 	return err
 }
 
-func (r *MongoRepository) DeleteBySlug(slug string) error {
-	_, err := r.Collection.DeleteOne(context.Background(), bson.M{"slug": slug})
+func (r *MongoRepository) DeleteBySlug(ctx context.Context, slug string) error {
+	_, err := r.Collection.DeleteOne(ctx, bson.M{"slug": slug})
 	return err
 }
 
-func (r *MongoRepository) ListCharacters(skip int64, limit int64) ([]models.Character, error) {
+func (r *MongoRepository) ListCharacters(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) ([]models.Character, error) {
 	var characters []models.Character
 	findOptions := options.Find()
 	if limit > 0 {
 		findOptions.SetSkip(skip).SetLimit(limit)
 	}
+	if projection := fieldsProjection(fields); projection != nil {
+		findOptions.SetProjection(projection)
+	}
 
-	cursor, err := r.Collection.Find(context.Background(), bson.D{}, findOptions)
+	cursor, err := r.Collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		var character models.Character
 		if err := cursor.Decode(&character); err != nil {
 			return nil, err
@@ -81,6 +133,190 @@ func (r *MongoRepository) ListCharacters(skip int64, limit int64) ([]models.Char
 	return characters, nil
 }
 
-func (r *MongoRepository) CountCharacters() (int64, error) {
-	return r.Collection.CountDocuments(context.Background(), bson.D{})
+func (r *MongoRepository) CountCharacters(ctx context.Context, filter bson.M) (int64, error) {
+	return r.Collection.CountDocuments(ctx, filter)
+}
+
+// Facets menghitung jumlah dokumen per nilai untuk setiap facet dalam satu aggregation $facet
+func (r *MongoRepository) Facets(ctx context.Context, filter bson.M, facets []string) (models.Facets, error) {
+	// nama output $facet tidak boleh mengandung titik (rank.ninjaRank), jadi stage diberi nama aman lalu dipetakan balik
+	stages := bson.M{}
+	names := map[string]string{}
+	for _, name := range facets {
+		stage := strings.ReplaceAll(name, ".", "_")
+		names[stage] = name
+		stages[stage] = bson.A{
+			bson.M{"$group": bson.M{"_id": "$" + FacetFields[name], "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$facet", Value: stages}},
+	}
+
+	cursor, err := r.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	result := models.Facets{}
+	if cursor.Next(ctx) {
+		var raw map[string][]models.FacetBucket
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		for stage, buckets := range raw {
+			result[names[stage]] = buckets
+		}
+	}
+	return result, cursor.Err()
+}
+
+// TextSearch mencari lewat text index; relevansi memakai bobot field yang didefinisikan di Indexes
+func (r *MongoRepository) TextSearch(ctx context.Context, query string, limit int64, fields ...string) ([]models.CharacterHit, error) {
+	score := bson.M{"$meta": "textScore"}
+	projection := fieldsProjection(fields)
+	if projection == nil {
+		projection = bson.M{}
+	}
+	projection["score"] = score
+
+	findOptions := options.Find().
+		SetProjection(projection).
+		SetSort(bson.D{{Key: "score", Value: score}})
+	if limit > 0 {
+		findOptions.SetLimit(limit)
+	}
+
+	cursor, err := r.Collection.Find(ctx, bson.M{"$text": bson.M{"$search": query}}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var hits []models.CharacterHit
+	if err := cursor.All(ctx, &hits); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// heightCm mengambil angka pertama dari personal.height ("180 cm" → 180); null jika tidak ada angka
+var heightCm = bson.M{"$convert": bson.M{
+	"input": bson.M{"$let": bson.M{
+		"vars": bson.M{"m": bson.M{"$regexFind": bson.M{"input": "$personal.height", "regex": `[0-9]+(\.[0-9]+)?`}}},
+		"in":   "$$m.match",
+	}},
+	"to":      "double",
+	"onError": nil,
+	"onNull":  nil,
+}}
+
+var jutsuCount = bson.M{"$size": bson.M{"$ifNull": bson.A{"$jutsu", bson.A{}}}}
+
+// countBy adalah stage $group + $sort untuk menghitung dokumen per nilai field
+func countBy(field string) bson.A {
+	return bson.A{
+		bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}},
+		bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+	}
+}
+
+// Stats menjalankan satu aggregation $facet untuk semua statistik dashboard.
+// top membatasi daftar jutsuCounts, groupBy (path field) menambahkan statistik per grup.
+func (r *MongoRepository) Stats(ctx context.Context, top int64, groupBy string) (*models.CharacterStats, error) {
+	facets := bson.M{
+		"total":     bson.A{bson.M{"$count": "count"}},
+		"byVillage": countBy("personal.affiliation"),
+		"byRank":    countBy("rank.ninjaRank"),
+		"byStatus":  countBy("personal.status"),
+		"averageHeightByClan": bson.A{
+			bson.M{"$addFields": bson.M{"heightCm": heightCm}},
+			bson.M{"$match": bson.M{"heightCm": bson.M{"$ne": nil}}},
+			bson.M{"$group": bson.M{"_id": "$personal.clan", "average": bson.M{"$avg": "$heightCm"}, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "_id", Value: 1}}},
+		},
+		"jutsuCounts": bson.A{
+			bson.M{"$project": bson.M{"_id": 0, "slug": 1, "name": 1, "count": jutsuCount}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "name", Value: 1}}},
+			bson.M{"$limit": top},
+		},
+	}
+	if groupBy != "" {
+		facets["groups"] = bson.A{
+			bson.M{"$group": bson.M{
+				"_id":           "$" + groupBy,
+				"count":         bson.M{"$sum": 1},
+				"averageHeight": bson.M{"$avg": heightCm},
+				"averageJutsu":  bson.M{"$avg": jutsuCount},
+			}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		}
+	}
+
+	cursor, err := r.Collection.Aggregate(ctx, mongo.Pipeline{{{Key: "$facet", Value: facets}}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		Total               []struct{ Count int64 } `bson:"total"`
+		ByVillage           []models.FacetBucket    `bson:"byVillage"`
+		ByRank              []models.FacetBucket    `bson:"byRank"`
+		ByStatus            []models.FacetBucket    `bson:"byStatus"`
+		AverageHeightByClan []models.AverageBucket  `bson:"averageHeightByClan"`
+		JutsuCounts         []models.JutsuCount     `bson:"jutsuCounts"`
+		Groups              []models.CharacterGroup `bson:"groups"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	stats := &models.CharacterStats{
+		ByVillage:           result.ByVillage,
+		ByRank:              result.ByRank,
+		AverageHeightByClan: result.AverageHeightByClan,
+		JutsuCounts:         result.JutsuCounts,
+		Groups:              result.Groups,
+	}
+	if len(result.Total) > 0 {
+		stats.Total = result.Total[0].Count
+	}
+	for _, b := range result.ByStatus {
+		switch strings.ToLower(b.Value) {
+		case "alive":
+			stats.Status.Alive += b.Count
+		case "deceased", "dead":
+			stats.Status.Deceased += b.Count
+		default:
+			stats.Status.Unknown += b.Count
+		}
+	}
+	if known := stats.Status.Alive + stats.Status.Deceased; known > 0 {
+		stats.Status.LivingRatio = float64(stats.Status.Alive) / float64(known)
+	}
+
+	return stats, nil
+}
+
+// fieldsProjection selalu menyertakan slug dan name karena dipakai untuk ranking, index autocomplete dan link
+func fieldsProjection(paths []string) bson.M {
+	return fields.Projection(paths, "slug", "name")
+}
+
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) ([]database.IndexStatus, error) {
+	return database.EnsureIndexes(ctx, r.Collection, Indexes)
+}
+
+func (r *MongoRepository) IndexStatus(ctx context.Context) ([]database.IndexStatus, error) {
+	return database.CheckIndexes(ctx, r.Collection, Indexes)
 }
@@ -0,0 +1,58 @@
+package character
+
+import (
+	"context"
+
+	"my-gin-app/models"
+	"my-gin-app/search"
+)
+
+// searchResource menghubungkan Service karakter ke pencarian gabungan /search
+type searchResource struct {
+	service Service
+}
+
+func NewSearchResource(service Service) search.Resource {
+	return &searchResource{
+		service: service,
+	}
+}
+
+func (r *searchResource) Type() string {
+	return "character"
+}
+
+// Search menggabungkan full-text search dan pencarian nama fuzzy supaya typo tetap menemukan hasil
+func (r *searchResource) Search(ctx context.Context, q string, limit int) ([]search.Result, error) {
+	hits, err := r.service.TextSearchCharacters(ctx, q, limit)
+	if err != nil && err.Error() != "no characters found" {
+		return nil, err
+	}
+
+	text := make([]search.Result, len(hits))
+	for i, h := range hits {
+		text[i] = search.Result{Type: r.Type(), Slug: h.Slug, Name: h.Name, Score: h.Score}
+	}
+	search.NormalizeScores(text)
+	for i := range text {
+		hits[i].Score = text[i].Score
+		text[i].Data = hits[i]
+	}
+
+	characters, err := r.service.SearchCharacters(ctx, q)
+	if err != nil && err.Error() != "no characters found" {
+		return nil, err
+	}
+
+	terms := search.Terms(q)
+	fuzzy := make([]search.Result, len(characters))
+	for i, c := range characters {
+		hit := models.CharacterHit{Character: c, Score: search.NameScore(q, c.Name)}
+		if h, ok := search.Highlight("name", c.Name, terms); ok {
+			hit.Highlights = []models.Highlight{h}
+		}
+		fuzzy[i] = search.Result{Type: r.Type(), Slug: c.Slug, Name: c.Name, Score: hit.Score, Data: hit}
+	}
+
+	return search.Merge(limit, text, fuzzy), nil
+}
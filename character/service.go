@@ -1,20 +1,29 @@
 package character
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"my-gin-app/models"
+	"my-gin-app/search"
 
 	"github.com/gosimple/slug"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type Service interface {
-	CreateCharacter(character *models.Character) error
-	GetCharacterBySlug(slug string) (*models.Character, error)
-	UpdateCharacter(slug string, updatedData *models.Character) error
-	DeleteCharacter(slug string) error
-	ListCharacters(page int, limit int) ([]models.Character, int64, error)
-	SearchCharacters(name string) ([]models.Character, error)
+	CreateCharacter(ctx context.Context, character *models.Character) error
+	GetCharacterBySlug(ctx context.Context, slug string, fields ...string) (*models.Character, error)
+	GetCharactersBySlugs(ctx context.Context, slugs []string) ([]models.Character, error)
+	UpdateCharacter(ctx context.Context, slug string, updatedData *models.Character) error
+	DeleteCharacter(ctx context.Context, slug string) error
+	ListCharacters(ctx context.Context, page int, limit int, filters map[string]string, fields ...string) ([]models.Character, int64, error)
+	CharacterFacets(ctx context.Context, facets []string, scope models.FacetScope) (models.Facets, error)
+	CharacterStats(ctx context.Context, top int, groupBy string) (*models.CharacterStats, error)
+	SearchCharacters(ctx context.Context, name string, fields ...string) ([]models.Character, error)
+	TextSearchCharacters(ctx context.Context, q string, limit int, fields ...string) ([]models.CharacterHit, error)
+	ReslugCharacters(ctx context.Context) (int, error)
 }
 
 type service struct {
@@ -27,18 +36,18 @@ func NewService(repo Repository) Service {
 	}
 }
 
-func (s *service) CreateCharacter(character *models.Character) error {
+func (s *service) CreateCharacter(ctx context.Context, character *models.Character) error {
 	// Validasi atau logika bisnis tambahan dapat ditambahkan di sini
 	character.Slug = slug.Make(character.Name)
-	return s.repo.Create(character)
+	return s.repo.Create(ctx, character)
 }
 
-func (s *service) GetCharacterBySlug(slug string) (*models.Character, error) {
-	return s.repo.FindBySlug(slug)
+func (s *service) GetCharacterBySlug(ctx context.Context, slugParam string, fields ...string) (*models.Character, error) {
+	return s.repo.FindBySlug(ctx, slugParam, fields...)
 }
 
-func (s *service) UpdateCharacter(slug string, updatedData *models.Character) error {
-	existingCharacter, err := s.repo.FindBySlug(slug)
+func (s *service) UpdateCharacter(ctx context.Context, slugParam string, updatedData *models.Character) error {
+	existingCharacter, err := s.repo.FindBySlug(ctx, slugParam)
 	if err != nil {
 		return err
 	}
@@ -78,14 +87,24 @@ func (s *service) UpdateCharacter(slug string, updatedData *models.Character) er
 		"jutsu":    existingCharacter.Jutsu,
 	}
 
-	return s.repo.UpdateBySlug(slug, update)
+	return s.repo.UpdateBySlug(ctx, slugParam, update)
 }
 
-func (s *service) DeleteCharacter(slug string) error {
-	return s.repo.DeleteBySlug(slug)
+// GetCharactersBySlugs mengambil banyak karakter sekaligus; slug yang tidak ada dilewati
+func (s *service) GetCharactersBySlugs(ctx context.Context, slugs []string) ([]models.Character, error) {
+	return s.repo.ListCharacters(ctx, bson.M{"slug": bson.M{"$in": slugs}}, 0, 0)
 }
 
-func (s *service) ListCharacters(page int, limit int) ([]models.Character, int64, error) {
+func (s *service) DeleteCharacter(ctx context.Context, slugParam string) error {
+	return s.repo.DeleteBySlug(ctx, slugParam)
+}
+
+func (s *service) ListCharacters(ctx context.Context, page int, limit int, filters map[string]string, fields ...string) ([]models.Character, int64, error) {
+	filter, err := buildFilter(models.FacetScope{Filters: filters})
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var skip int64
 	var lim int64
 	if limit > 0 && page > 0 {
@@ -93,12 +112,12 @@ func (s *service) ListCharacters(page int, limit int) ([]models.Character, int64
 		lim = int64(limit)
 	}
 
-	characters, err := s.repo.ListCharacters(skip, lim)
+	characters, err := s.repo.ListCharacters(ctx, filter, skip, lim, fields...)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	count, err := s.repo.CountCharacters()
+	count, err := s.repo.CountCharacters(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -106,32 +125,144 @@ func (s *service) ListCharacters(page int, limit int) ([]models.Character, int64
 	return characters, count, nil
 }
 
-func (s *service) SearchCharacters(name string) ([]models.Character, error) {
+func (s *service) SearchCharacters(ctx context.Context, name string, fields ...string) ([]models.Character, error) {
 	if name == "" {
 		return nil, errors.New("name query parameter is required")
 	}
 
-	// Implementasi pencarian langsung di repository bisa lebih efisien
-	// Namun untuk contoh ini, kita gunakan ListCharacters dan filter manual
-	characters, err := s.repo.ListCharacters(0, 0)
+	characters, err := s.repo.ListCharacters(ctx, bson.M{}, 0, 0, fields...)
 	if err != nil {
 		return nil, err
 	}
 
-	var filtered []models.Character
-	for _, c := range characters {
-		if containsIgnoreCase(c.Name, name) {
-			filtered = append(filtered, c)
+	// Pencocokan fuzzy: toleran typo, makron/vokal panjang dan variasi romanisasi
+	names := make([]string, len(characters))
+	for i, c := range characters {
+		names[i] = c.Name
+	}
+
+	matches := search.Rank(name, names)
+	if len(matches) == 0 {
+		return nil, &search.NoMatchError{
+			Message:    "no characters found",
+			DidYouMean: search.Suggest(name, names),
 		}
 	}
 
-	if len(filtered) == 0 {
-		return nil, errors.New("no characters found")
+	filtered := make([]models.Character, len(matches))
+	for i, m := range matches {
+		filtered[i] = characters[m.Index]
 	}
 
 	return filtered, nil
 }
 
-func containsIgnoreCase(str, substr string) bool {
-	return len(str) >= len(substr) && (str == substr || len(str) > 0 && (containsIgnoreCase(str[1:], substr) || str[:len(substr)] == substr))
+// TextSearchCharacters mencari di name, jutsu, occupation, clan dan affiliation, urut berdasarkan relevansi
+func (s *service) TextSearchCharacters(ctx context.Context, q string, limit int, fields ...string) ([]models.CharacterHit, error) {
+	if q == "" {
+		return nil, errors.New("q query parameter is required")
+	}
+
+	hits, err := s.repo.TextSearch(ctx, q, int64(limit), fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hits) == 0 {
+		return nil, errors.New("no characters found")
+	}
+
+	terms := search.Terms(q)
+	for i := range hits {
+		c := hits[i].Character
+		var highlights []models.Highlight
+		if h, ok := search.Highlight("name", c.Name, terms); ok {
+			highlights = append(highlights, h)
+		}
+		highlights = append(highlights, search.HighlightAll("jutsu", c.Jutsu, terms)...)
+		highlights = append(highlights, search.HighlightAll("personal.clan", []string{c.Personal.Clan}, terms)...)
+		highlights = append(highlights, search.HighlightAll("personal.affiliation", []string{c.Personal.Affiliation}, terms)...)
+		highlights = append(highlights, search.HighlightAll("personal.occupation", []string{c.Personal.Occupation}, terms)...)
+		hits[i].Highlights = highlights
+	}
+
+	return hits, nil
+}
+
+// CharacterFacets menghitung bucket per facet untuk dokumen dalam scope
+func (s *service) CharacterFacets(ctx context.Context, facets []string, scope models.FacetScope) (models.Facets, error) {
+	if len(facets) == 0 {
+		return nil, errors.New("invalid facets: at least one facet is required")
+	}
+	for _, name := range facets {
+		if _, ok := FacetFields[name]; !ok {
+			return nil, fmt.Errorf("invalid facet %s", name)
+		}
+	}
+
+	filter, err := buildFilter(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Facets(ctx, filter, facets)
+}
+
+// CharacterStats mengambil statistik dashboard; groupBy memakai nama facet (clan, affiliation, ...)
+func (s *service) CharacterStats(ctx context.Context, top int, groupBy string) (*models.CharacterStats, error) {
+	var field string
+	if groupBy != "" {
+		var ok bool
+		if field, ok = FacetFields[groupBy]; !ok {
+			return nil, fmt.Errorf("invalid groupBy %s", groupBy)
+		}
+	}
+
+	stats, err := s.repo.Stats(ctx, int64(top), field)
+	if err != nil {
+		return nil, err
+	}
+	stats.GroupBy = groupBy
+	return stats, nil
+}
+
+// ReslugCharacters menghitung ulang slug dari nama untuk karakter yang slug-nya tidak sesuai
+func (s *service) ReslugCharacters(ctx context.Context) (int, error) {
+	characters, err := s.repo.ListCharacters(ctx, bson.M{}, 0, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, c := range characters {
+		newSlug := slug.Make(c.Name)
+		if newSlug == c.Slug {
+			continue
+		}
+		if err := s.repo.UpdateBySlug(ctx, c.Slug, map[string]interface{}{"slug": newSlug}); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// buildFilter menerjemahkan FacetScope menjadi filter MongoDB
+func buildFilter(scope models.FacetScope) (bson.M, error) {
+	filter := bson.M{}
+	for name, value := range scope.Filters {
+		field, ok := FacetFields[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid filter %s", name)
+		}
+		filter[field] = value
+	}
+	if scope.Slugs != nil {
+		filter["slug"] = bson.M{"$in": scope.Slugs}
+	}
+	if scope.Text != "" {
+		filter["$text"] = bson.M{"$search": scope.Text}
+	}
+	return filter, nil
 }
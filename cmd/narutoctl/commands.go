@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/gosimple/slug"
+)
+
+//go:embed seed/dataset.json
+var seedFiles embed.FS
+
+// importStats menghitung hasil seed/import per resource
+type importStats struct {
+	created, updated, skipped int
+}
+
+func (s importStats) String() string {
+	return fmt.Sprintf("%d created, %d updated, %d skipped", s.created, s.updated, s.skipped)
+}
+
+func (a *app) seed(ctx context.Context, resource string) error {
+	b, err := seedFiles.ReadFile("seed/dataset.json")
+	if err != nil {
+		return err
+	}
+
+	data, err := decodeDataset(bytes.NewReader(b), "json", resourceAll)
+	if err != nil {
+		return err
+	}
+
+	return a.load(ctx, data, resource, false)
+}
+
+func (a *app) importFile(ctx context.Context, path, format, resource string, update bool) error {
+	data, err := readDatasetFile(path, format, resource)
+	if err != nil {
+		return err
+	}
+
+	if problems := validateDataset(data); len(problems) > 0 {
+		for _, p := range problems {
+			log.Println(p)
+		}
+		return fmt.Errorf("%s: %d validation problem(s), nothing imported", path, len(problems))
+	}
+
+	return a.load(ctx, data, resource, update)
+}
+
+// load membuat dokumen baru lewat service; slug yang sudah ada dilewati kecuali update=true
+func (a *app) load(ctx context.Context, data *dataset, resource string, update bool) error {
+	if resource != resourceTailedBeast {
+		var stats importStats
+		for i := range data.Characters {
+			c := &data.Characters[i]
+			existing, err := a.characters.GetCharacterBySlug(ctx, slug.Make(c.Name))
+			switch {
+			case err != nil && err.Error() != "character not found":
+				return err
+			case existing == nil:
+				if err := a.characters.CreateCharacter(ctx, c); err != nil {
+					return err
+				}
+				stats.created++
+			case update:
+				if err := a.characters.UpdateCharacter(ctx, existing.Slug, c); err != nil {
+					return err
+				}
+				stats.updated++
+			default:
+				stats.skipped++
+			}
+		}
+		log.Printf("characters: %s", stats)
+	}
+
+	if resource != resourceCharacter {
+		var stats importStats
+		for i := range data.TailedBeasts {
+			b := &data.TailedBeasts[i]
+			existing, err := a.tailedBeasts.GetBeastBySlug(ctx, slug.Make(b.Name))
+			switch {
+			case err != nil && err.Error() != "tailed beast not found":
+				return err
+			case existing == nil:
+				if err := a.tailedBeasts.CreateBeast(ctx, b); err != nil {
+					return err
+				}
+				stats.created++
+			case update:
+				if err := a.tailedBeasts.UpdateBeast(ctx, existing.Slug, b); err != nil {
+					return err
+				}
+				stats.updated++
+			default:
+				stats.skipped++
+			}
+		}
+		log.Printf("tailed beasts: %s", stats)
+	}
+
+	return nil
+}
+
+func (a *app) export(ctx context.Context, out, format, resource string) error {
+	if format == "" && out == "" {
+		format = "json"
+	}
+	format, err := detectFormat(format, out)
+	if err != nil {
+		return err
+	}
+
+	var data dataset
+	if resource != resourceTailedBeast {
+		data.Characters, _, err = a.characters.ListCharacters(ctx, 0, 0, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if resource != resourceCharacter {
+		data.TailedBeasts, _, err = a.tailedBeasts.ListBeasts(ctx, 0, 0, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return encodeDataset(w, &data, format, resource)
+}
+
+func (a *app) reslug(ctx context.Context, resource string) error {
+	if resource != resourceTailedBeast {
+		n, err := a.characters.ReslugCharacters(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("characters: %d slug(s) updated", n)
+	}
+	if resource != resourceCharacter {
+		n, err := a.tailedBeasts.ReslugBeasts(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("tailed beasts: %d slug(s) updated", n)
+	}
+	return nil
+}
+
+func (a *app) purge(ctx context.Context, resource string) error {
+	if resource != resourceTailedBeast {
+		characters, _, err := a.characters.ListCharacters(ctx, 0, 0, nil)
+		if err != nil {
+			return err
+		}
+		for _, c := range characters {
+			if err := a.characters.DeleteCharacter(ctx, c.Slug); err != nil {
+				return err
+			}
+		}
+		log.Printf("characters: %d deleted", len(characters))
+	}
+	if resource != resourceCharacter {
+		beasts, _, err := a.tailedBeasts.ListBeasts(ctx, 0, 0, nil)
+		if err != nil {
+			return err
+		}
+		for _, b := range beasts {
+			if err := a.tailedBeasts.DeleteBeast(ctx, b.Slug); err != nil {
+				return err
+			}
+		}
+		log.Printf("tailed beasts: %d deleted", len(beasts))
+	}
+	return nil
+}
+
+func runValidate(path, format, resource string) error {
+	data, err := readDatasetFile(path, format, resource)
+	if err != nil {
+		return err
+	}
+
+	problems := validateDataset(data)
+	for _, p := range problems {
+		log.Println(p)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s: %d validation problem(s)", path, len(problems))
+	}
+
+	log.Printf("%s: ok (%d characters, %d tailed beasts)", path, len(data.Characters), len(data.TailedBeasts))
+	return nil
+}
+
+func readDatasetFile(path, format, resource string) (*dataset, error) {
+	format, err := detectFormat(format, path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := decodeDataset(f, format, resource)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return data, nil
+}
+
+// validateDataset memeriksa nama wajib diisi dan slug tidak bentrok di dalam satu file
+func validateDataset(data *dataset) []string {
+	var problems []string
+
+	seen := map[string]int{}
+	for i, c := range data.Characters {
+		problems = append(problems, validateEntry("character", i, c.Name, seen)...)
+	}
+
+	seen = map[string]int{}
+	for i, b := range data.TailedBeasts {
+		problems = append(problems, validateEntry("tailed beast", i, b.Name, seen)...)
+	}
+
+	return problems
+}
+
+func validateEntry(kind string, index int, name string, seen map[string]int) []string {
+	if name == "" {
+		return []string{fmt.Sprintf("%s #%d: name is required", kind, index+1)}
+	}
+
+	s := slug.Make(name)
+	if first, ok := seen[s]; ok {
+		return []string{fmt.Sprintf("%s #%d: slug %q duplicates entry #%d", kind, index+1, s, first+1)}
+	}
+	seen[s] = index
+	return nil
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"my-gin-app/models"
+)
+
+// listSeparator memisahkan elemen slice (images, jutsu, abilities) di dalam satu sel CSV
+const listSeparator = "|"
+
+// dataset adalah bentuk file JSON/YAML yang bisa memuat kedua resource sekaligus
+type dataset struct {
+	Characters   []models.Character   `json:"characters,omitempty"`
+	TailedBeasts []models.TailedBeast `json:"tailedBeasts,omitempty"`
+}
+
+// detectFormat menentukan format dari flag, atau dari ekstensi file jika flag kosong
+func detectFormat(format, path string) (string, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml":
+			format = "yaml"
+		case ".csv":
+			format = "csv"
+		default:
+			format = "json"
+		}
+	}
+
+	switch format {
+	case "json", "yaml", "csv":
+		return format, nil
+	}
+	return "", fmt.Errorf("unsupported format %q (use json, yaml or csv)", format)
+}
+
+// decodeDataset membaca dataset dari r. CSV hanya memuat satu resource sehingga resource wajib diisi.
+func decodeDataset(r io.Reader, format, resource string) (*dataset, error) {
+	var data dataset
+
+	switch format {
+	case "json":
+		if err := decodeJSON(r, resource, &data); err != nil {
+			return nil, err
+		}
+	case "yaml":
+		// YAML dikonversi ke JSON dulu supaya tag json pada models tetap dipakai
+		var raw interface{}
+		if err := yaml.NewDecoder(r).Decode(&raw); err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+		if err := decodeJSON(strings.NewReader(string(b)), resource, &data); err != nil {
+			return nil, err
+		}
+	case "csv":
+		switch resource {
+		case resourceCharacter:
+			if err := readCSV(r, &data.Characters); err != nil {
+				return nil, err
+			}
+		case resourceTailedBeast:
+			if err := readCSV(r, &data.TailedBeasts); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("csv input needs -type %s or -type %s", resourceCharacter, resourceTailedBeast)
+		}
+	}
+
+	return &data, nil
+}
+
+// decodeJSON menerima dataset lengkap, atau array polos jika resource disebutkan
+func decodeJSON(r io.Reader, resource string, data *dataset) error {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	switch resource {
+	case resourceCharacter:
+		return dec.Decode(&data.Characters)
+	case resourceTailedBeast:
+		return dec.Decode(&data.TailedBeasts)
+	}
+	return dec.Decode(data)
+}
+
+// encodeDataset menulis dataset ke w sesuai format
+func encodeDataset(w io.Writer, data *dataset, format, resource string) error {
+	var payload interface{} = data
+	switch resource {
+	case resourceCharacter:
+		payload = data.Characters
+	case resourceTailedBeast:
+		payload = data.TailedBeasts
+	}
+
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(payload)
+	case "yaml":
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		var raw interface{}
+		if err := yaml.Unmarshal(b, &raw); err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		defer enc.Close()
+		return enc.Encode(raw)
+	case "csv":
+		switch resource {
+		case resourceCharacter:
+			return writeCSV(w, data.Characters)
+		case resourceTailedBeast:
+			return writeCSV(w, data.TailedBeasts)
+		}
+		return fmt.Errorf("csv output needs -type %s or -type %s", resourceCharacter, resourceTailedBeast)
+	}
+	return fmt.Errorf("unsupported format %q", format)
+}
+
+// readCSV mengisi slice struct dari CSV dengan header berupa path json (misal personal.clan)
+func readCSV(r io.Reader, out interface{}) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return err
+	}
+
+	slice := reflect.ValueOf(out).Elem()
+	known := csvColumns(slice.Type().Elem(), "")
+	for _, col := range header {
+		if !contains(known, col) {
+			return fmt.Errorf("unknown csv column %q", col)
+		}
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		row := make(map[string]string, len(header))
+		for i, col := range header {
+			row[col] = record[i]
+		}
+
+		item := reflect.New(slice.Type().Elem()).Elem()
+		unflatten(item, "", row)
+		slice.Set(reflect.Append(slice, item))
+	}
+}
+
+// writeCSV menulis slice struct ke CSV dengan kolom dari csvColumns
+func writeCSV(w io.Writer, items interface{}) error {
+	slice := reflect.ValueOf(items)
+	columns := csvColumns(slice.Type().Elem(), "")
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+
+	for i := 0; i < slice.Len(); i++ {
+		row := make(map[string]string, len(columns))
+		flatten(slice.Index(i), "", row)
+
+		record := make([]string, len(columns))
+		for j, col := range columns {
+			record[j] = row[col]
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvColumns menurunkan nama kolom dari tag json, struct bersarang digabung dengan titik
+func csvColumns(t reflect.Type, prefix string) []string {
+	var columns []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := prefix + jsonName(field)
+		if field.Type.Kind() == reflect.Struct {
+			columns = append(columns, csvColumns(field.Type, name+".")...)
+			continue
+		}
+		columns = append(columns, name)
+	}
+	return columns
+}
+
+func flatten(v reflect.Value, prefix string, out map[string]string) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := prefix + jsonName(field)
+		value := v.Field(i)
+
+		switch value.Kind() {
+		case reflect.Struct:
+			flatten(value, name+".", out)
+		case reflect.Slice:
+			parts := make([]string, value.Len())
+			for j := range parts {
+				parts[j] = value.Index(j).String()
+			}
+			out[name] = strings.Join(parts, listSeparator)
+		default:
+			out[name] = value.String()
+		}
+	}
+}
+
+func unflatten(v reflect.Value, prefix string, row map[string]string) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := prefix + jsonName(field)
+		value := v.Field(i)
+
+		switch value.Kind() {
+		case reflect.Struct:
+			unflatten(value, name+".", row)
+		case reflect.Slice:
+			cell := strings.TrimSpace(row[name])
+			if cell == "" {
+				continue
+			}
+			parts := strings.Split(cell, listSeparator)
+			list := reflect.MakeSlice(value.Type(), len(parts), len(parts))
+			for j, part := range parts {
+				list.Index(j).SetString(strings.TrimSpace(part))
+			}
+			value.Set(list)
+		case reflect.String:
+			value.SetString(row[name])
+		}
+	}
+}
+
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+// Command narutoctl mengelola data naruto_api tanpa lewat HTTP: seed, import, export,
+// validate, reslug dan purge. Koneksi MongoDB dibaca dari konfigurasi yang sama dengan server (file config, .env, env).
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"my-gin-app/character"
+	"my-gin-app/config"
+	"my-gin-app/database"
+	"my-gin-app/migrations"
+	"my-gin-app/tailedbeast"
+)
+
+const (
+	resourceAll         = "all"
+	resourceCharacter   = "character"
+	resourceTailedBeast = "tailedbeast"
+)
+
+const usage = `Usage: narutoctl [flags] <command> [args]
+
+Commands:
+  seed            load the bundled canonical dataset (existing slugs are skipped)
+  import <file>   load characters/tailed beasts from a JSON, YAML or CSV file
+  export          write the collections to stdout (or -out)
+  validate <file> check a file without touching the database
+  reslug          recompute slugs from names where they drifted
+  purge           delete every document of the selected type (needs -yes)
+  migrate <up|down [n]|status>
+                  apply, revert (default 1 step) or list schema migrations
+
+Flags:
+`
+
+// app menyimpan service yang sama dengan yang dipakai handler HTTP
+type app struct {
+	characters   character.Service
+	tailedBeasts tailedbeast.Service
+	migrator     *migrations.Migrator
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("narutoctl: ")
+
+	configFile := flag.String("config", "", "optional YAML or TOML config file with the mongo settings (default: CONFIG_FILE)")
+	envFile := flag.String("env", ".env", "optional .env file with MONGO_* settings")
+	resource := flag.String("type", resourceAll, "resource to operate on: all, character or tailedbeast")
+	format := flag.String("format", "", "input/output format: json, yaml or csv (default: from file extension, json for export)")
+	out := flag.String("out", "", "export destination file (default: stdout)")
+	update := flag.Bool("update", false, "import: overwrite documents whose slug already exists")
+	yes := flag.Bool("yes", false, "purge: confirm deletion")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	switch *resource {
+	case resourceAll, resourceCharacter, resourceTailedBeast:
+	default:
+		log.Fatalf("unknown -type %q", *resource)
+	}
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	command, args := args[0], args[1:]
+
+	// validate tidak butuh database
+	if command == "validate" {
+		if len(args) != 1 {
+			log.Fatal("validate needs exactly one file")
+		}
+		if err := runValidate(args[0], *format, *resource); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	cfg, err := config.Load(config.Sources{File: *configFile, EnvFile: *envFile})
+	if err != nil {
+		log.Fatalf("invalid config:\n%v", err)
+	}
+
+	ctx := context.Background()
+	client, err := database.Connect(ctx, cfg.Mongo)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Disconnect(ctx)
+
+	db := database.Database(client, cfg.Mongo)
+	a := &app{
+		characters:   character.NewService(character.NewRepository(database.CharacterCollection(db, cfg.Mongo))),
+		tailedBeasts: tailedbeast.NewService(tailedbeast.NewRepository(database.TailedBeastCollection(db, cfg.Mongo))),
+		migrator: migrations.NewMigrator(migrations.Target{
+			DB:           db,
+			Characters:   database.CharacterCollection(db, cfg.Mongo),
+			TailedBeasts: database.TailedBeastCollection(db, cfg.Mongo),
+		}),
+	}
+
+	switch command {
+	case "seed":
+		err = a.seed(ctx, *resource)
+	case "import":
+		if len(args) != 1 {
+			log.Fatal("import needs exactly one file")
+		}
+		err = a.importFile(ctx, args[0], *format, *resource, *update)
+	case "export":
+		err = a.export(ctx, *out, *format, *resource)
+	case "reslug":
+		err = a.reslug(ctx, *resource)
+	case "purge":
+		if !*yes {
+			log.Fatal("purge deletes data; re-run with -yes to confirm")
+		}
+		err = a.purge(ctx, *resource)
+	case "migrate":
+		err = a.migrate(ctx, args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
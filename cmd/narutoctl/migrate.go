@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+func (a *app) migrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("migrate needs up, down or status")
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := a.migrator.Up(ctx)
+		for _, m := range applied {
+			log.Printf("applied %d: %s", m.Version, m.Description)
+		}
+		if err == nil && len(applied) == 0 {
+			log.Println("nothing to apply")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid step count %q", args[1])
+			}
+			steps = n
+		}
+		reverted, err := a.migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			log.Printf("reverted %d: %s", m.Version, m.Description)
+		}
+		return err
+	case "status":
+		statuses, err := a.migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%4d  %-28s  %s\n", s.Version, state, s.Description)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown migrate subcommand %q", args[0])
+}
@@ -0,0 +1,249 @@
+// Package config memuat konfigurasi aplikasi berlapis: default, file config (YAML/TOML), .env, lalu
+// environment variable. Lapisan berikutnya menimpa lapisan sebelumnya.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-gin-app/middleware"
+	"my-gin-app/ratelimit"
+)
+
+// Config adalah konfigurasi server dan narutoctl. Tag config adalah kunci di file config,
+// tag env nama environment variable, dan tag secret menandai nilai yang tidak boleh ditampilkan.
+type Config struct {
+	HTTP      HTTP      `config:"http"`
+	GRPC      GRPC      `config:"grpc"`
+	Mongo     Mongo     `config:"mongo"`
+	Timeouts  Timeouts  `config:"timeouts"`
+	Log       Log       `config:"log"`
+	RateLimit RateLimit `config:"rateLimit"`
+	CORS      CORS      `config:"cors"`
+
+	StatsCacheTTL    time.Duration `config:"statsCacheTTL" env:"STATS_CACHE_TTL"`
+	MigrateOnStartup bool          `config:"migrateOnStartup" env:"MIGRATE_ON_STARTUP"`
+	LegacyResponses  bool          `config:"legacyResponses" env:"LEGACY_RESPONSES"`
+	APIKey           string        `config:"apiKey" env:"X_API_KEY" secret:"true"`
+}
+
+type HTTP struct {
+	Port int `config:"port" env:"PORT"`
+	// TrustedProxies adalah daftar IP/CIDR dipisah koma yang boleh mengirim X-Forwarded-For;
+	// kosong berarti IP client diambil dari koneksi langsung
+	TrustedProxies string `config:"trustedProxies" env:"TRUSTED_PROXIES"`
+}
+
+// Addr adalah alamat listen server HTTP
+func (h HTTP) Addr() string {
+	return ":" + strconv.Itoa(h.Port)
+}
+
+type GRPC struct {
+	Addr string `config:"addr" env:"GRPC_ADDR"`
+}
+
+type Mongo struct {
+	URI                   string `config:"uri" env:"MONGO_URI" secret:"uri"`
+	Database              string `config:"database" env:"MONGO_DB"`
+	CharacterCollection   string `config:"characterCollection" env:"MONGO_COLLECTION"`
+	TailedBeastCollection string `config:"tailedBeastCollection" env:"MONGO_COLLECTION_TAILEDBEAST"`
+}
+
+// Timeouts: Request 0 berarti tanpa deadline; Routes berformat "GET /search=5s,/stats/characters=30s"
+type Timeouts struct {
+	Request     time.Duration `config:"request" env:"REQUEST_TIMEOUT"`
+	Routes      string        `config:"routes" env:"ROUTE_TIMEOUTS"`
+	Shutdown    time.Duration `config:"shutdown" env:"SHUTDOWN_TIMEOUT"`
+	HealthCheck time.Duration `config:"healthCheck" env:"HEALTH_CHECK_TIMEOUT"`
+}
+
+// RateLimit berisi kuota per class route dengan format "<request>/<window>", misal "120/1m"
+type RateLimit struct {
+	Enabled bool   `config:"enabled" env:"RATE_LIMIT_ENABLED"`
+	Read    string `config:"read" env:"RATE_LIMIT_READ"`
+	Write   string `config:"write" env:"RATE_LIMIT_WRITE"`
+	Export  string `config:"export" env:"RATE_LIMIT_EXPORT"`
+}
+
+// CORS berisi daftar dipisah koma (di file config boleh berupa list). AllowedOrigins kosong mematikan CORS.
+type CORS struct {
+	AllowedOrigins   string        `config:"allowedOrigins" env:"CORS_ALLOWED_ORIGINS"`
+	AllowedMethods   string        `config:"allowedMethods" env:"CORS_ALLOWED_METHODS"`
+	AllowedHeaders   string        `config:"allowedHeaders" env:"CORS_ALLOWED_HEADERS"`
+	ExposedHeaders   string        `config:"exposedHeaders" env:"CORS_EXPOSED_HEADERS"`
+	AllowCredentials bool          `config:"allowCredentials" env:"CORS_ALLOW_CREDENTIALS"`
+	MaxAge           time.Duration `config:"maxAge" env:"CORS_MAX_AGE"`
+}
+
+type Log struct {
+	Level  string `config:"level" env:"LOG_LEVEL"`
+	Format string `config:"format" env:"LOG_FORMAT"`
+}
+
+// Default mengembalikan nilai yang dipakai jika tidak diatur di file maupun env
+func Default() *Config {
+	return &Config{
+		HTTP: HTTP{Port: 8001},
+		GRPC: GRPC{Addr: ":9001"},
+		Timeouts: Timeouts{
+			Request:     10 * time.Second,
+			Shutdown:    15 * time.Second,
+			HealthCheck: 2 * time.Second,
+		},
+		Log: Log{Level: "info", Format: "json"},
+		RateLimit: RateLimit{
+			Enabled: true,
+			Read:    "120/1m",
+			Write:   "30/1m",
+			Export:  "10/1m",
+		},
+		CORS: CORS{
+			AllowedMethods: "GET,POST,PUT,DELETE",
+			AllowedHeaders: "Content-Type,X-API-Key,If-Match,If-None-Match,X-Request-ID,traceparent,tracestate,baggage",
+			ExposedHeaders: "X-Request-ID,RateLimit-Limit,RateLimit-Remaining,RateLimit-Reset,RateLimit-Policy,Retry-After,Deprecation,Sunset,Link",
+			MaxAge:         10 * time.Minute,
+		},
+		StatsCacheTTL: 5 * time.Minute,
+	}
+}
+
+// RouteTimeouts mengembalikan deadline per route untuk middleware.Timeout
+func (c *Config) RouteTimeouts() (middleware.Timeouts, error) {
+	return middleware.ParseTimeouts(c.Timeouts.Request, c.Timeouts.Routes)
+}
+
+// Proxies mengembalikan TrustedProxies sebagai daftar untuk gin.Engine.SetTrustedProxies
+func (h HTTP) Proxies() []string {
+	return list(h.TrustedProxies)
+}
+
+// Enabled bernilai true jika ada origin yang diizinkan
+func (c CORS) Enabled() bool {
+	return len(list(c.AllowedOrigins)) > 0
+}
+
+// Policy mengembalikan kebijakan untuk middleware.CORS
+func (c CORS) Policy() middleware.CORSPolicy {
+	return middleware.CORSPolicy{
+		AllowedOrigins:   list(c.AllowedOrigins),
+		AllowedMethods:   list(c.AllowedMethods),
+		AllowedHeaders:   list(c.AllowedHeaders),
+		ExposedHeaders:   list(c.ExposedHeaders),
+		AllowCredentials: c.AllowCredentials,
+		MaxAge:           c.MaxAge,
+	}
+}
+
+// list memecah nilai dipisah koma dan membuang entri kosong
+func list(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Quotas mengembalikan kuota rate limit per class
+func (r RateLimit) Quotas() (map[ratelimit.Class]ratelimit.Quota, error) {
+	quotas := map[ratelimit.Class]ratelimit.Quota{}
+	var errs []error
+	values := map[ratelimit.Class]string{
+		ratelimit.Read:   r.Read,
+		ratelimit.Write:  r.Write,
+		ratelimit.Export: r.Export,
+	}
+	for _, class := range []ratelimit.Class{ratelimit.Read, ratelimit.Write, ratelimit.Export} {
+		q, err := ratelimit.ParseQuota(values[class])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("RATE_LIMIT_%s: %v", strings.ToUpper(string(class)), err))
+			continue
+		}
+		quotas[class] = q
+	}
+	return quotas, errors.Join(errs...)
+}
+
+// Validate memeriksa semua nilai sekaligus supaya setiap kesalahan terlihat dalam satu kali start
+func (c *Config) Validate() error {
+	var errs []error
+	required := map[string]string{
+		"MONGO_URI":                    c.Mongo.URI,
+		"MONGO_DB":                     c.Mongo.Database,
+		"MONGO_COLLECTION":             c.Mongo.CharacterCollection,
+		"MONGO_COLLECTION_TAILEDBEAST": c.Mongo.TailedBeastCollection,
+		"GRPC_ADDR":                    c.GRPC.Addr,
+	}
+	for _, name := range sortedKeys(required) {
+		if strings.TrimSpace(required[name]) == "" {
+			errs = append(errs, fmt.Errorf("%s is required", name))
+		}
+	}
+
+	if c.HTTP.Port < 1 || c.HTTP.Port > 65535 {
+		errs = append(errs, fmt.Errorf("PORT %d is out of range", c.HTTP.Port))
+	}
+	if c.GRPC.Addr == c.HTTP.Addr() {
+		errs = append(errs, fmt.Errorf("GRPC_ADDR %s is already used by the HTTP server", c.GRPC.Addr))
+	}
+
+	for _, p := range c.HTTP.Proxies() {
+		if net.ParseIP(p) == nil {
+			if _, _, err := net.ParseCIDR(p); err != nil {
+				errs = append(errs, fmt.Errorf("TRUSTED_PROXIES: %q is not an IP or CIDR", p))
+			}
+		}
+	}
+	if c.RateLimit.Enabled {
+		if _, err := c.RateLimit.Quotas(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if c.CORS.Enabled() {
+		if err, ok := c.CORS.Policy().Validate().(interface{ Unwrap() []error }); ok {
+			for _, e := range err.Unwrap() {
+				errs = append(errs, fmt.Errorf("CORS: %v", e))
+			}
+		}
+	}
+
+	durations := map[string]time.Duration{
+		"REQUEST_TIMEOUT":      c.Timeouts.Request,
+		"SHUTDOWN_TIMEOUT":     c.Timeouts.Shutdown,
+		"HEALTH_CHECK_TIMEOUT": c.Timeouts.HealthCheck,
+		"STATS_CACHE_TTL":      c.StatsCacheTTL,
+		"CORS_MAX_AGE":         c.CORS.MaxAge,
+	}
+	for _, name := range sortedKeys(durations) {
+		if durations[name] < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative", name))
+		}
+	}
+	if c.Timeouts.Shutdown == 0 {
+		errs = append(errs, errors.New("SHUTDOWN_TIMEOUT must be greater than 0"))
+	}
+	if c.Timeouts.HealthCheck == 0 {
+		errs = append(errs, errors.New("HEALTH_CHECK_TIMEOUT must be greater than 0"))
+	}
+	if _, err := c.RouteTimeouts(); err != nil {
+		errs = append(errs, fmt.Errorf("ROUTE_TIMEOUTS: %v", err))
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL %q must be debug, info, warn or error", c.Log.Level))
+	}
+	if f := strings.ToLower(c.Log.Format); f != "json" && f != "text" {
+		errs = append(errs, fmt.Errorf("LOG_FORMAT %q must be json or text", c.Log.Format))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,293 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// redacted menggantikan nilai secret saat konfigurasi ditampilkan
+const redacted = "REDACTED"
+
+// Sources menentukan file yang dibaca Load. File kosong berarti memakai CONFIG_FILE (boleh diset di .env),
+// atau tanpa file config jika itu juga kosong. EnvFile yang tidak ada dilewati karena di container env
+// biasanya sudah diset langsung.
+type Sources struct {
+	File    string
+	EnvFile string
+}
+
+// field adalah satu nilai konfigurasi beserta kunci file dan nama env-nya
+type field struct {
+	path   []string
+	env    string
+	secret string
+	value  reflect.Value
+}
+
+func (f field) key() string {
+	return strings.Join(f.path, ".")
+}
+
+// Load membangun konfigurasi dari default, file config, .env lalu environment variable, kemudian memvalidasinya.
+// Isi .env juga diekspor ke environment proses, jadi variabel di luar Config (misal OTEL_* yang dibaca
+// OpenTelemetry) tetap bisa diset di .env.
+func Load(src Sources) (*Config, error) {
+	cfg := Default()
+	fields := cfg.fields()
+
+	if src.EnvFile != "" {
+		if err := exportEnvFile(src.EnvFile); err != nil {
+			return nil, err
+		}
+	}
+	if src.File == "" {
+		src.File = os.Getenv("CONFIG_FILE")
+	}
+
+	if src.File != "" {
+		values, err := readFile(src.File)
+		if err != nil {
+			return nil, err
+		}
+		if err := applyFile(fields, values); err != nil {
+			return nil, fmt.Errorf("%s: %w", src.File, err)
+		}
+	}
+
+	// nilai kosong dianggap tidak diset
+	for _, f := range fields {
+		v := os.Getenv(f.env)
+		if v == "" {
+			continue
+		}
+		if err := set(f.value, v); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", f.env, v, err)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// exportEnvFile mengekspor isi .env ke environment proses. Variabel yang sudah diset (dan tidak kosong)
+// menang atas .env, sama seperti godotenv.Load.
+func exportEnvFile(path string) error {
+	values, err := godotenv.Read(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, name := range sortedKeys(values) {
+		if os.Getenv(name) != "" {
+			continue
+		}
+		if err := os.Setenv(name, values[name]); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// Redacted mengembalikan konfigurasi efektif sebagai map bertingkat (kunci file config) dengan secret disamarkan
+func (c *Config) Redacted() map[string]interface{} {
+	out := map[string]interface{}{}
+	for _, f := range c.fields() {
+		m := out
+		for _, p := range f.path[:len(f.path)-1] {
+			next, ok := m[p].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				m[p] = next
+			}
+			m = next
+		}
+		m[f.path[len(f.path)-1]] = display(f)
+	}
+	return out
+}
+
+func display(f field) interface{} {
+	switch v := f.value.Interface().(type) {
+	case time.Duration:
+		return v.String()
+	case string:
+		if v == "" {
+			return v
+		}
+		switch f.secret {
+		case "true":
+			return redacted
+		case "uri":
+			return redactURI(v)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// redactURI menyamarkan password di connection string MongoDB; host dan user tetap terlihat.
+// Tidak memakai url.Parse karena URI dengan beberapa host (h1:27017,h2:27017) ditolaknya.
+func redactURI(uri string) string {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return redacted
+	}
+	userinfo, host, ok := strings.Cut(rest, "@")
+	if !ok {
+		return uri
+	}
+	user, _, ok := strings.Cut(userinfo, ":")
+	if !ok {
+		return uri
+	}
+	return scheme + "://" + user + ":" + redacted + "@" + host
+}
+
+// fields mengumpulkan setiap nilai konfigurasi dari struct Config secara rekursif
+func (c *Config) fields() []field {
+	var out []field
+	var walk func(v reflect.Value, path []string)
+	walk = func(v reflect.Value, path []string) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			p := append(append([]string{}, path...), sf.Tag.Get("config"))
+			if sf.Type.Kind() == reflect.Struct {
+				walk(v.Field(i), p)
+				continue
+			}
+			out = append(out, field{
+				path:   p,
+				env:    sf.Tag.Get("env"),
+				secret: sf.Tag.Get("secret"),
+				value:  v.Field(i),
+			})
+		}
+	}
+	walk(reflect.ValueOf(c).Elem(), nil)
+	return out
+}
+
+// readFile membaca file config; formatnya ditentukan dari ekstensi (.yaml, .yml atau .toml)
+func readFile(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		err = toml.Unmarshal(data, &values)
+	default:
+		return nil, fmt.Errorf("%s: unsupported config format %q (use .yaml, .yml or .toml)", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return values, nil
+}
+
+// applyFile menyalin nilai file ke field; kunci yang tidak dikenal dianggap salah ketik dan ditolak
+func applyFile(fields []field, values map[string]interface{}) error {
+	byKey := map[string]field{}
+	for _, f := range fields {
+		byKey[f.key()] = f
+	}
+
+	flat := map[string]interface{}{}
+	flatten(flat, "", values)
+
+	var errs []error
+	for _, key := range sortedKeys(flat) {
+		f, ok := byKey[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown key %s", key))
+			continue
+		}
+		value := flat[key]
+		// list di file config (misal cors.allowedOrigins) disimpan sebagai teks dipisah koma seperti env
+		if items, ok := value.([]interface{}); ok {
+			parts := make([]string, len(items))
+			for i, item := range items {
+				parts[i] = fmt.Sprint(item)
+			}
+			value = strings.Join(parts, ",")
+		}
+		if err := set(f.value, fmt.Sprint(value)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s %v: %v", key, flat[key], err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func flatten(out map[string]interface{}, prefix string, values map[string]interface{}) {
+	for k, v := range values {
+		key := prefix + k
+		if nested, ok := v.(map[string]interface{}); ok {
+			flatten(out, key+".", nested)
+			continue
+		}
+		out[key] = v
+	}
+}
+
+// set mengisi field dari teks, dipakai untuk nilai file maupun env
+func set(v reflect.Value, s string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	default:
+		return fmt.Errorf("unsupported config type %s", v.Type())
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
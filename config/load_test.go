@@ -0,0 +1,105 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadExportsEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	envFile := filepath.Join(dir, ".env")
+	writeFile(t, file, "http:\n  port: 8081\n")
+	writeFile(t, envFile, "MONGO_URI=mongodb://db:27017\nMONGO_DB=naruto\nMONGO_COLLECTION=characters\nMONGO_COLLECTION_TAILEDBEAST=beasts\n"+
+		"OTEL_EXPORTER_OTLP_ENDPOINT=http://collector:4317\nOTEL_SERVICE_NAME=from-dotenv\nCONFIG_FILE="+file+"\n")
+
+	// t.Setenv memulihkan nilai asli setelah test, termasuk variabel yang diekspor dari .env
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("OTEL_SERVICE_NAME", "from-env")
+	for _, name := range []string{"PORT", "MONGO_URI", "MONGO_DB", "MONGO_COLLECTION", "MONGO_COLLECTION_TAILEDBEAST"} {
+		t.Setenv(name, "")
+	}
+
+	cfg, err := Load(Sources{EnvFile: envFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); got != "http://collector:4317" {
+		t.Errorf("OTEL_EXPORTER_OTLP_ENDPOINT = %q, want the value from .env", got)
+	}
+	if got := os.Getenv("OTEL_SERVICE_NAME"); got != "from-env" {
+		t.Errorf("OTEL_SERVICE_NAME = %q, .env must not override the environment", got)
+	}
+	if cfg.HTTP.Port != 8081 {
+		t.Errorf("CONFIG_FILE from .env was not read: port %d", cfg.HTTP.Port)
+	}
+}
+
+func TestConfigLayers(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	envFile := filepath.Join(dir, ".env")
+	writeFile(t, file, "http:\n  port: 8080\nmongo:\n  uri: mongodb://naruto:dattebayo@db:27017\n  database: file\ntimeouts:\n  request: 3s\n")
+	writeFile(t, envFile, "MONGO_DB=dotenv\nMONGO_COLLECTION=characters\nMONGO_COLLECTION_TAILEDBEAST=beasts\nREQUEST_TIMEOUT=4s\n")
+	// env kosong dianggap tidak diset, jadi nilai dari environment pengembang tidak ikut terbaca
+	for _, name := range []string{"PORT", "MONGO_URI", "MONGO_DB", "MONGO_COLLECTION", "MONGO_COLLECTION_TAILEDBEAST"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("REQUEST_TIMEOUT", "5s")
+	t.Setenv("X_API_KEY", "secret-key")
+
+	cfg, err := Load(Sources{File: file, EnvFile: envFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.HTTP.Addr() != ":8080" || cfg.Mongo.Database != "dotenv" || cfg.Timeouts.Request != 5*time.Second || cfg.Timeouts.Shutdown != 15*time.Second {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+
+	out, _ := json.Marshal(cfg.Redacted())
+	if strings.Contains(string(out), "dattebayo") || strings.Contains(string(out), "secret-key") {
+		t.Fatalf("secrets not redacted: %s", out)
+	}
+
+	if _, err := Load(Sources{EnvFile: filepath.Join(dir, "missing.env")}); err == nil || !strings.Contains(err.Error(), "MONGO_URI is required") {
+		t.Fatalf("missing .env without MONGO_URI returned %v", err)
+	}
+}
+
+func TestLoadReadsStartupTogglesFromEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, ".env")
+	writeFile(t, envFile, "MONGO_URI=mongodb://db:27017\nMONGO_DB=naruto\nMONGO_COLLECTION=characters\nMONGO_COLLECTION_TAILEDBEAST=beasts\n"+
+		"LEGACY_RESPONSES=true\nMIGRATE_ON_STARTUP=true\n")
+	for _, name := range []string{"CONFIG_FILE", "MONGO_URI", "MONGO_DB", "MONGO_COLLECTION", "MONGO_COLLECTION_TAILEDBEAST", "LEGACY_RESPONSES", "MIGRATE_ON_STARTUP"} {
+		t.Setenv(name, "")
+	}
+
+	cfg, err := Load(Sources{EnvFile: envFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.LegacyResponses || !cfg.MigrateOnStartup {
+		t.Fatalf("LEGACY_RESPONSES and MIGRATE_ON_STARTUP from .env were ignored: %+v", cfg)
+	}
+
+	t.Setenv("LEGACY_RESPONSES", "false")
+	if cfg, err = Load(Sources{EnvFile: envFile}); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.LegacyResponses {
+		t.Fatal("environment must override LEGACY_RESPONSES from .env")
+	}
+}
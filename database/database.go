@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"my-gin-app/config"
+)
+
+// Connect membuka koneksi ke MongoDB berdasarkan cfg.URI dan memastikan server bisa di-ping.
+// opts ditambahkan setelah URI, misal untuk memasang monitor pool.
+func Connect(ctx context.Context, cfg config.Mongo, opts ...*options.ClientOptions) (*mongo.Client, error) {
+	clientOptions := options.Client().ApplyURI(cfg.URI)
+	client, err := mongo.Connect(ctx, append([]*options.ClientOptions{clientOptions}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Ping(ctx, nil); err != nil {
+		_ = client.Disconnect(ctx)
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// Database mengembalikan database yang dikonfigurasi lewat MONGO_DB
+func Database(client *mongo.Client, cfg config.Mongo) *mongo.Database {
+	return client.Database(cfg.Database)
+}
+
+// CharacterCollection mengembalikan collection karakter (MONGO_COLLECTION)
+func CharacterCollection(db *mongo.Database, cfg config.Mongo) *mongo.Collection {
+	return db.Collection(cfg.CharacterCollection)
+}
+
+// TailedBeastCollection mengembalikan collection tailed beast (MONGO_COLLECTION_TAILEDBEAST)
+func TailedBeastCollection(db *mongo.Database, cfg config.Mongo) *mongo.Collection {
+	return db.Collection(cfg.TailedBeastCollection)
+}
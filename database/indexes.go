@@ -0,0 +1,198 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Index adalah definisi index yang diharapkan ada pada sebuah collection.
+// Untuk text index, Keys berisi field dengan nilai "text" dan Weights berisi bobot per field.
+type Index struct {
+	Name    string
+	Keys    bson.D
+	Unique  bool
+	Weights bson.D
+}
+
+const (
+	IndexOK      = "ok"
+	IndexMissing = "missing"
+	IndexCreated = "created"
+	IndexDrift   = "drift"
+	IndexExtra   = "extra"
+	IndexError   = "error"
+)
+
+// IndexStatus adalah hasil perbandingan satu index antara definisi dan database
+type IndexStatus struct {
+	Collection string `json:"collection"`
+	Name       string `json:"name"`
+	State      string `json:"state"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// existingIndex adalah bentuk dokumen dari listIndexes yang relevan untuk perbandingan
+type existingIndex struct {
+	Name    string `bson:"name"`
+	Key     bson.D `bson:"key"`
+	Unique  bool   `bson:"unique"`
+	Weights bson.M `bson:"weights"`
+}
+
+// CheckIndexes membandingkan definisi index dengan index yang ada tanpa mengubah apa pun
+func CheckIndexes(ctx context.Context, collection *mongo.Collection, defs []Index) ([]IndexStatus, error) {
+	existing, err := listIndexes(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]IndexStatus, 0, len(defs))
+	declared := map[string]bool{"_id_": true}
+	for _, def := range defs {
+		declared[def.Name] = true
+		status := IndexStatus{Collection: collection.Name(), Name: def.Name, State: IndexOK}
+
+		idx, ok := existing[def.Name]
+		if !ok {
+			status.State = IndexMissing
+		} else if diff := compareIndex(def, idx); diff != "" {
+			status.State = IndexDrift
+			status.Detail = diff
+		}
+		statuses = append(statuses, status)
+	}
+
+	for name := range existing {
+		if !declared[name] {
+			statuses = append(statuses, IndexStatus{
+				Collection: collection.Name(),
+				Name:       name,
+				State:      IndexExtra,
+				Detail:     "index exists but is not declared",
+			})
+		}
+	}
+
+	return statuses, nil
+}
+
+// EnsureIndexes membuat index yang belum ada. Index yang berbeda (drift) tidak diubah
+// karena drop/rebuild di production harus dilakukan manual; statusnya dikembalikan sebagai peringatan.
+func EnsureIndexes(ctx context.Context, collection *mongo.Collection, defs []Index) ([]IndexStatus, error) {
+	statuses, err := CheckIndexes(ctx, collection, defs)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]Index, len(defs))
+	for _, def := range defs {
+		byName[def.Name] = def
+	}
+
+	for i, status := range statuses {
+		if status.State != IndexMissing {
+			continue
+		}
+
+		def := byName[status.Name]
+		opts := options.Index().SetName(def.Name)
+		if def.Unique {
+			opts.SetUnique(true)
+		}
+		if len(def.Weights) > 0 {
+			opts.SetWeights(def.Weights)
+		}
+
+		_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: def.Keys, Options: opts})
+		if err != nil {
+			statuses[i].State = IndexError
+			statuses[i].Detail = err.Error()
+			continue
+		}
+		statuses[i].State = IndexCreated
+	}
+
+	return statuses, nil
+}
+
+func listIndexes(ctx context.Context, collection *mongo.Collection) (map[string]existingIndex, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	existing := map[string]existingIndex{}
+	for cursor.Next(ctx) {
+		var idx existingIndex
+		if err := cursor.Decode(&idx); err != nil {
+			return nil, err
+		}
+		existing[idx.Name] = idx
+	}
+	return existing, cursor.Err()
+}
+
+// compareIndex mengembalikan deskripsi perbedaan, atau string kosong jika sama
+func compareIndex(def Index, idx existingIndex) string {
+	if def.Unique != idx.Unique {
+		return fmt.Sprintf("unique: want %t, have %t", def.Unique, idx.Unique)
+	}
+
+	if isTextIndex(def) {
+		// Text index disimpan sebagai {_fts: "text", _ftsx: 1}; field dan bobot ada di weights
+		want := map[string]int32{}
+		for _, k := range def.Keys {
+			want[k.Key] = 1
+		}
+		for _, w := range def.Weights {
+			want[w.Key] = toInt32(w.Value)
+		}
+		if len(want) != len(idx.Weights) {
+			return fmt.Sprintf("text fields: want %v, have %v", want, idx.Weights)
+		}
+		for field, weight := range want {
+			if toInt32(idx.Weights[field]) != weight {
+				return fmt.Sprintf("text weight %s: want %d, have %v", field, weight, idx.Weights[field])
+			}
+		}
+		return ""
+	}
+
+	if len(def.Keys) != len(idx.Key) {
+		return fmt.Sprintf("keys: want %v, have %v", def.Keys, idx.Key)
+	}
+	for i, k := range def.Keys {
+		if k.Key != idx.Key[i].Key || toInt32(k.Value) != toInt32(idx.Key[i].Value) {
+			return fmt.Sprintf("keys: want %v, have %v", def.Keys, idx.Key)
+		}
+	}
+	return ""
+}
+
+func isTextIndex(def Index) bool {
+	for _, k := range def.Keys {
+		if k.Value == "text" {
+			return true
+		}
+	}
+	return false
+}
+
+func toInt32(v interface{}) int32 {
+	switch n := v.(type) {
+	case int:
+		return int32(n)
+	case int32:
+		return n
+	case int64:
+		return int32(n)
+	case float64:
+		return int32(n)
+	}
+	return 0
+}
@@ -0,0 +1,140 @@
+// Package fields menangani sparse fieldset (?fields=name,slug,personal.clan): validasi path,
+// projection MongoDB, dan pemangkasan respons JSON supaya hanya berisi field yang diminta.
+package fields
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Parse memecah parameter fields dan memastikan setiap path ada di model (berdasarkan tag json)
+func Parse(raw string, model interface{}) ([]string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	valid := map[string]bool{}
+	collectPaths(reflect.TypeOf(model), "", valid)
+
+	var paths []string
+	for _, p := range strings.Split(raw, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !valid[p] {
+			return nil, fmt.Errorf("invalid field %s", p)
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// Projection membuat projection MongoDB untuk fields ditambah field yang selalu dibutuhkan (misal slug).
+// Mengembalikan nil jika fields kosong, artinya semua field diambil.
+func Projection(fields []string, always ...string) bson.M {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	projection := bson.M{"_id": 0}
+	for _, f := range append(always, fields...) {
+		projection[f] = 1
+	}
+
+	// path bersarang yang induknya juga diminta akan ditolak MongoDB (path collision)
+	for f := range projection {
+		for parent := range projection {
+			if parent != f && strings.HasPrefix(f, parent+".") {
+				delete(projection, f)
+			}
+		}
+	}
+	return projection
+}
+
+// Select memangkas v (struct atau slice of struct) menjadi hanya path yang diminta beserta keep.
+// Jika fields kosong, v dikembalikan apa adanya.
+func Select(v interface{}, fields []string, keep ...string) (interface{}, error) {
+	if len(fields) == 0 {
+		return v, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	paths := append(append([]string{}, fields...), keep...)
+	switch d := doc.(type) {
+	case []interface{}:
+		for i, item := range d {
+			if m, ok := item.(map[string]interface{}); ok {
+				d[i] = pick(m, paths)
+			}
+		}
+		return d, nil
+	case map[string]interface{}:
+		return pick(d, paths), nil
+	}
+	return doc, nil
+}
+
+func pick(doc map[string]interface{}, paths []string) map[string]interface{} {
+	out := map[string]interface{}{}
+	for _, p := range paths {
+		head, rest, nested := strings.Cut(p, ".")
+		value, ok := doc[head]
+		if !ok {
+			continue
+		}
+		if !nested {
+			out[head] = value
+			continue
+		}
+
+		child, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sub, _ := out[head].(map[string]interface{})
+		if sub == nil {
+			sub = map[string]interface{}{}
+			out[head] = sub
+		}
+		for k, v := range pick(child, []string{rest}) {
+			sub[k] = v
+		}
+	}
+	return out
+}
+
+func collectPaths(t reflect.Type, prefix string, valid map[string]bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			collectPaths(field.Type, prefix, valid)
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		valid[prefix+name] = true
+		if field.Type.Kind() == reflect.Struct {
+			collectPaths(field.Type, prefix+name+".", valid)
+		}
+	}
+}
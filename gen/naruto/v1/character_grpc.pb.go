@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: naruto/v1/character.proto
+
+package narutov1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CharacterService_GetCharacter_FullMethodName     = "/naruto.v1.CharacterService/GetCharacter"
+	CharacterService_ListCharacters_FullMethodName   = "/naruto.v1.CharacterService/ListCharacters"
+	CharacterService_SearchCharacters_FullMethodName = "/naruto.v1.CharacterService/SearchCharacters"
+	CharacterService_CreateCharacter_FullMethodName  = "/naruto.v1.CharacterService/CreateCharacter"
+	CharacterService_UpdateCharacter_FullMethodName  = "/naruto.v1.CharacterService/UpdateCharacter"
+	CharacterService_DeleteCharacter_FullMethodName  = "/naruto.v1.CharacterService/DeleteCharacter"
+)
+
+// CharacterServiceClient is the client API for CharacterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CharacterService exposes the same operations as the /v1/character REST endpoints.
+type CharacterServiceClient interface {
+	GetCharacter(ctx context.Context, in *GetCharacterRequest, opts ...grpc.CallOption) (*GetCharacterResponse, error)
+	// ListCharacters streams every matching character; the total count is sent in the
+	// "x-total-count" response header.
+	ListCharacters(ctx context.Context, in *ListCharactersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListCharactersResponse], error)
+	SearchCharacters(ctx context.Context, in *SearchCharactersRequest, opts ...grpc.CallOption) (*SearchCharactersResponse, error)
+	CreateCharacter(ctx context.Context, in *CreateCharacterRequest, opts ...grpc.CallOption) (*CreateCharacterResponse, error)
+	UpdateCharacter(ctx context.Context, in *UpdateCharacterRequest, opts ...grpc.CallOption) (*UpdateCharacterResponse, error)
+	DeleteCharacter(ctx context.Context, in *DeleteCharacterRequest, opts ...grpc.CallOption) (*DeleteCharacterResponse, error)
+}
+
+type characterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCharacterServiceClient(cc grpc.ClientConnInterface) CharacterServiceClient {
+	return &characterServiceClient{cc}
+}
+
+func (c *characterServiceClient) GetCharacter(ctx context.Context, in *GetCharacterRequest, opts ...grpc.CallOption) (*GetCharacterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCharacterResponse)
+	err := c.cc.Invoke(ctx, CharacterService_GetCharacter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) ListCharacters(ctx context.Context, in *ListCharactersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListCharactersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CharacterService_ServiceDesc.Streams[0], CharacterService_ListCharacters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListCharactersRequest, ListCharactersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CharacterService_ListCharactersClient = grpc.ServerStreamingClient[ListCharactersResponse]
+
+func (c *characterServiceClient) SearchCharacters(ctx context.Context, in *SearchCharactersRequest, opts ...grpc.CallOption) (*SearchCharactersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCharactersResponse)
+	err := c.cc.Invoke(ctx, CharacterService_SearchCharacters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) CreateCharacter(ctx context.Context, in *CreateCharacterRequest, opts ...grpc.CallOption) (*CreateCharacterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCharacterResponse)
+	err := c.cc.Invoke(ctx, CharacterService_CreateCharacter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) UpdateCharacter(ctx context.Context, in *UpdateCharacterRequest, opts ...grpc.CallOption) (*UpdateCharacterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCharacterResponse)
+	err := c.cc.Invoke(ctx, CharacterService_UpdateCharacter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) DeleteCharacter(ctx context.Context, in *DeleteCharacterRequest, opts ...grpc.CallOption) (*DeleteCharacterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCharacterResponse)
+	err := c.cc.Invoke(ctx, CharacterService_DeleteCharacter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CharacterServiceServer is the server API for CharacterService service.
+// All implementations must embed UnimplementedCharacterServiceServer
+// for forward compatibility.
+//
+// CharacterService exposes the same operations as the /v1/character REST endpoints.
+type CharacterServiceServer interface {
+	GetCharacter(context.Context, *GetCharacterRequest) (*GetCharacterResponse, error)
+	// ListCharacters streams every matching character; the total count is sent in the
+	// "x-total-count" response header.
+	ListCharacters(*ListCharactersRequest, grpc.ServerStreamingServer[ListCharactersResponse]) error
+	SearchCharacters(context.Context, *SearchCharactersRequest) (*SearchCharactersResponse, error)
+	CreateCharacter(context.Context, *CreateCharacterRequest) (*CreateCharacterResponse, error)
+	UpdateCharacter(context.Context, *UpdateCharacterRequest) (*UpdateCharacterResponse, error)
+	DeleteCharacter(context.Context, *DeleteCharacterRequest) (*DeleteCharacterResponse, error)
+	mustEmbedUnimplementedCharacterServiceServer()
+}
+
+// UnimplementedCharacterServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCharacterServiceServer struct{}
+
+func (UnimplementedCharacterServiceServer) GetCharacter(context.Context, *GetCharacterRequest) (*GetCharacterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacter not implemented")
+}
+func (UnimplementedCharacterServiceServer) ListCharacters(*ListCharactersRequest, grpc.ServerStreamingServer[ListCharactersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListCharacters not implemented")
+}
+func (UnimplementedCharacterServiceServer) SearchCharacters(context.Context, *SearchCharactersRequest) (*SearchCharactersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCharacters not implemented")
+}
+func (UnimplementedCharacterServiceServer) CreateCharacter(context.Context, *CreateCharacterRequest) (*CreateCharacterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCharacter not implemented")
+}
+func (UnimplementedCharacterServiceServer) UpdateCharacter(context.Context, *UpdateCharacterRequest) (*UpdateCharacterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCharacter not implemented")
+}
+func (UnimplementedCharacterServiceServer) DeleteCharacter(context.Context, *DeleteCharacterRequest) (*DeleteCharacterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCharacter not implemented")
+}
+func (UnimplementedCharacterServiceServer) mustEmbedUnimplementedCharacterServiceServer() {}
+func (UnimplementedCharacterServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeCharacterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CharacterServiceServer will
+// result in compilation errors.
+type UnsafeCharacterServiceServer interface {
+	mustEmbedUnimplementedCharacterServiceServer()
+}
+
+func RegisterCharacterServiceServer(s grpc.ServiceRegistrar, srv CharacterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCharacterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CharacterService_ServiceDesc, srv)
+}
+
+func _CharacterService_GetCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).GetCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_GetCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).GetCharacter(ctx, req.(*GetCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_ListCharacters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCharactersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CharacterServiceServer).ListCharacters(m, &grpc.GenericServerStream[ListCharactersRequest, ListCharactersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CharacterService_ListCharactersServer = grpc.ServerStreamingServer[ListCharactersResponse]
+
+func _CharacterService_SearchCharacters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCharactersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).SearchCharacters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_SearchCharacters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).SearchCharacters(ctx, req.(*SearchCharactersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_CreateCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).CreateCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_CreateCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).CreateCharacter(ctx, req.(*CreateCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_UpdateCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).UpdateCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_UpdateCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).UpdateCharacter(ctx, req.(*UpdateCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_DeleteCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).DeleteCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_DeleteCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).DeleteCharacter(ctx, req.(*DeleteCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CharacterService_ServiceDesc is the grpc.ServiceDesc for CharacterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CharacterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "naruto.v1.CharacterService",
+	HandlerType: (*CharacterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCharacter",
+			Handler:    _CharacterService_GetCharacter_Handler,
+		},
+		{
+			MethodName: "SearchCharacters",
+			Handler:    _CharacterService_SearchCharacters_Handler,
+		},
+		{
+			MethodName: "CreateCharacter",
+			Handler:    _CharacterService_CreateCharacter_Handler,
+		},
+		{
+			MethodName: "UpdateCharacter",
+			Handler:    _CharacterService_UpdateCharacter_Handler,
+		},
+		{
+			MethodName: "DeleteCharacter",
+			Handler:    _CharacterService_DeleteCharacter_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCharacters",
+			Handler:       _CharacterService_ListCharacters_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "naruto/v1/character.proto",
+}
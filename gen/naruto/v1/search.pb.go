@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: naruto/v1/search.proto
+
+package narutov1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_naruto_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Highlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Highlight) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+var File_naruto_v1_search_proto protoreflect.FileDescriptor
+
+var file_naruto_v1_search_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x22, 0x3b, 0x0a, 0x09, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x42, 0x23, 0x5a, 0x21, 0x6d, 0x79, 0x2d, 0x67, 0x69, 0x6e, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x61, 0x72,
+	0x75, 0x74, 0x6f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_naruto_v1_search_proto_rawDescOnce sync.Once
+	file_naruto_v1_search_proto_rawDescData = file_naruto_v1_search_proto_rawDesc
+)
+
+func file_naruto_v1_search_proto_rawDescGZIP() []byte {
+	file_naruto_v1_search_proto_rawDescOnce.Do(func() {
+		file_naruto_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_naruto_v1_search_proto_rawDescData)
+	})
+	return file_naruto_v1_search_proto_rawDescData
+}
+
+var file_naruto_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_naruto_v1_search_proto_goTypes = []any{
+	(*Highlight)(nil), // 0: naruto.v1.Highlight
+}
+var file_naruto_v1_search_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_naruto_v1_search_proto_init() }
+func file_naruto_v1_search_proto_init() {
+	if File_naruto_v1_search_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_naruto_v1_search_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_naruto_v1_search_proto_goTypes,
+		DependencyIndexes: file_naruto_v1_search_proto_depIdxs,
+		MessageInfos:      file_naruto_v1_search_proto_msgTypes,
+	}.Build()
+	File_naruto_v1_search_proto = out.File
+	file_naruto_v1_search_proto_rawDesc = nil
+	file_naruto_v1_search_proto_goTypes = nil
+	file_naruto_v1_search_proto_depIdxs = nil
+}
@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: naruto/v1/tailed_beast.proto
+
+package narutov1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TailedBeast struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug        string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Images      []string               `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Rank        string                 `protobuf:"bytes,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Abilities   []string               `protobuf:"bytes,5,rep,name=abilities,proto3" json:"abilities,omitempty"`
+	Personality string                 `protobuf:"bytes,6,opt,name=personality,proto3" json:"personality,omitempty"`
+	// Slug of the host character.
+	Jinchuriki    string `protobuf:"bytes,7,opt,name=jinchuriki,proto3" json:"jinchuriki,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailedBeast) Reset() {
+	*x = TailedBeast{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailedBeast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailedBeast) ProtoMessage() {}
+
+func (x *TailedBeast) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailedBeast.ProtoReflect.Descriptor instead.
+func (*TailedBeast) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TailedBeast) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TailedBeast) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *TailedBeast) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *TailedBeast) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *TailedBeast) GetAbilities() []string {
+	if x != nil {
+		return x.Abilities
+	}
+	return nil
+}
+
+func (x *TailedBeast) GetPersonality() string {
+	if x != nil {
+		return x.Personality
+	}
+	return ""
+}
+
+func (x *TailedBeast) GetJinchuriki() string {
+	if x != nil {
+		return x.Jinchuriki
+	}
+	return ""
+}
+
+type TailedBeastHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TailedBeast   *TailedBeast           `protobuf:"bytes,1,opt,name=tailed_beast,json=tailedBeast,proto3" json:"tailed_beast,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights    []*Highlight           `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailedBeastHit) Reset() {
+	*x = TailedBeastHit{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailedBeastHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailedBeastHit) ProtoMessage() {}
+
+func (x *TailedBeastHit) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailedBeastHit.ProtoReflect.Descriptor instead.
+func (*TailedBeastHit) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TailedBeastHit) GetTailedBeast() *TailedBeast {
+	if x != nil {
+		return x.TailedBeast
+	}
+	return nil
+}
+
+func (x *TailedBeastHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TailedBeastHit) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type GetTailedBeastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTailedBeastRequest) Reset() {
+	*x = GetTailedBeastRequest{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTailedBeastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTailedBeastRequest) ProtoMessage() {}
+
+func (x *GetTailedBeastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTailedBeastRequest.ProtoReflect.Descriptor instead.
+func (*GetTailedBeastRequest) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTailedBeastRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type GetTailedBeastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TailedBeast   *TailedBeast           `protobuf:"bytes,1,opt,name=tailed_beast,json=tailedBeast,proto3" json:"tailed_beast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTailedBeastResponse) Reset() {
+	*x = GetTailedBeastResponse{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTailedBeastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTailedBeastResponse) ProtoMessage() {}
+
+func (x *GetTailedBeastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTailedBeastResponse.ProtoReflect.Descriptor instead.
+func (*GetTailedBeastResponse) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTailedBeastResponse) GetTailedBeast() *TailedBeast {
+	if x != nil {
+		return x.TailedBeast
+	}
+	return nil
+}
+
+type ListTailedBeastsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page and limit paginate only when both are set; otherwise every tailed beast is streamed.
+	Page  int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Exact-match filters keyed by facet name, e.g. "rank".
+	Filters       map[string]string `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTailedBeastsRequest) Reset() {
+	*x = ListTailedBeastsRequest{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTailedBeastsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTailedBeastsRequest) ProtoMessage() {}
+
+func (x *ListTailedBeastsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTailedBeastsRequest.ProtoReflect.Descriptor instead.
+func (*ListTailedBeastsRequest) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTailedBeastsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTailedBeastsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTailedBeastsRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type ListTailedBeastsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TailedBeast   *TailedBeast           `protobuf:"bytes,1,opt,name=tailed_beast,json=tailedBeast,proto3" json:"tailed_beast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTailedBeastsResponse) Reset() {
+	*x = ListTailedBeastsResponse{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTailedBeastsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTailedBeastsResponse) ProtoMessage() {}
+
+func (x *ListTailedBeastsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTailedBeastsResponse.ProtoReflect.Descriptor instead.
+func (*ListTailedBeastsResponse) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTailedBeastsResponse) GetTailedBeast() *TailedBeast {
+	if x != nil {
+		return x.TailedBeast
+	}
+	return nil
+}
+
+type SearchTailedBeastsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fuzzy name match, used when q is empty.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Full-text query with relevance score and highlights.
+	Q             string `protobuf:"bytes,2,opt,name=q,proto3" json:"q,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTailedBeastsRequest) Reset() {
+	*x = SearchTailedBeastsRequest{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTailedBeastsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTailedBeastsRequest) ProtoMessage() {}
+
+func (x *SearchTailedBeastsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTailedBeastsRequest.ProtoReflect.Descriptor instead.
+func (*SearchTailedBeastsRequest) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchTailedBeastsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchTailedBeastsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchTailedBeastsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchTailedBeastsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hits  []*TailedBeastHit      `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Suggested spelling when a name search has no matches.
+	DidYouMean    string `protobuf:"bytes,2,opt,name=did_you_mean,json=didYouMean,proto3" json:"did_you_mean,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTailedBeastsResponse) Reset() {
+	*x = SearchTailedBeastsResponse{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTailedBeastsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTailedBeastsResponse) ProtoMessage() {}
+
+func (x *SearchTailedBeastsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTailedBeastsResponse.ProtoReflect.Descriptor instead.
+func (*SearchTailedBeastsResponse) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchTailedBeastsResponse) GetHits() []*TailedBeastHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchTailedBeastsResponse) GetDidYouMean() string {
+	if x != nil {
+		return x.DidYouMean
+	}
+	return ""
+}
+
+type CreateTailedBeastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TailedBeast   *TailedBeast           `protobuf:"bytes,1,opt,name=tailed_beast,json=tailedBeast,proto3" json:"tailed_beast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTailedBeastRequest) Reset() {
+	*x = CreateTailedBeastRequest{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTailedBeastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTailedBeastRequest) ProtoMessage() {}
+
+func (x *CreateTailedBeastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTailedBeastRequest.ProtoReflect.Descriptor instead.
+func (*CreateTailedBeastRequest) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateTailedBeastRequest) GetTailedBeast() *TailedBeast {
+	if x != nil {
+		return x.TailedBeast
+	}
+	return nil
+}
+
+type CreateTailedBeastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TailedBeast   *TailedBeast           `protobuf:"bytes,1,opt,name=tailed_beast,json=tailedBeast,proto3" json:"tailed_beast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTailedBeastResponse) Reset() {
+	*x = CreateTailedBeastResponse{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTailedBeastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTailedBeastResponse) ProtoMessage() {}
+
+func (x *CreateTailedBeastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTailedBeastResponse.ProtoReflect.Descriptor instead.
+func (*CreateTailedBeastResponse) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateTailedBeastResponse) GetTailedBeast() *TailedBeast {
+	if x != nil {
+		return x.TailedBeast
+	}
+	return nil
+}
+
+type UpdateTailedBeastRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Empty fields keep their current value.
+	TailedBeast   *TailedBeast `protobuf:"bytes,2,opt,name=tailed_beast,json=tailedBeast,proto3" json:"tailed_beast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTailedBeastRequest) Reset() {
+	*x = UpdateTailedBeastRequest{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTailedBeastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTailedBeastRequest) ProtoMessage() {}
+
+func (x *UpdateTailedBeastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTailedBeastRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTailedBeastRequest) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTailedBeastRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateTailedBeastRequest) GetTailedBeast() *TailedBeast {
+	if x != nil {
+		return x.TailedBeast
+	}
+	return nil
+}
+
+type UpdateTailedBeastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TailedBeast   *TailedBeast           `protobuf:"bytes,1,opt,name=tailed_beast,json=tailedBeast,proto3" json:"tailed_beast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTailedBeastResponse) Reset() {
+	*x = UpdateTailedBeastResponse{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTailedBeastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTailedBeastResponse) ProtoMessage() {}
+
+func (x *UpdateTailedBeastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTailedBeastResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTailedBeastResponse) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTailedBeastResponse) GetTailedBeast() *TailedBeast {
+	if x != nil {
+		return x.TailedBeast
+	}
+	return nil
+}
+
+type DeleteTailedBeastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTailedBeastRequest) Reset() {
+	*x = DeleteTailedBeastRequest{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTailedBeastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTailedBeastRequest) ProtoMessage() {}
+
+func (x *DeleteTailedBeastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTailedBeastRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTailedBeastRequest) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTailedBeastRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type DeleteTailedBeastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTailedBeastResponse) Reset() {
+	*x = DeleteTailedBeastResponse{}
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTailedBeastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTailedBeastResponse) ProtoMessage() {}
+
+func (x *DeleteTailedBeastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_naruto_v1_tailed_beast_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTailedBeastResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTailedBeastResponse) Descriptor() ([]byte, []int) {
+	return file_naruto_v1_tailed_beast_proto_rawDescGZIP(), []int{13}
+}
+
+var File_naruto_v1_tailed_beast_proto protoreflect.FileDescriptor
+
+var file_naruto_v1_tailed_beast_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x6e, 0x61, 0x72, 0x75, 0x74,
+	0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6a, 0x69, 0x6e, 0x63, 0x68, 0x75, 0x72,
+	0x69, 0x6b, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6a, 0x69, 0x6e, 0x63, 0x68,
+	0x75, 0x72, 0x69, 0x6b, 0x69, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x42, 0x65, 0x61, 0x73, 0x74, 0x48, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65,
+	0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
+	0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x53, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x62, 0x65, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e,
+	0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42,
+	0x65, 0x61, 0x73, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73,
+	0x74, 0x22, 0xca, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x42, 0x65, 0x61, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42,
+	0x65, 0x61, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x42, 0x65, 0x61, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x01, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6d, 0x0a, 0x1a, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x48, 0x69,
+	0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x69, 0x64, 0x5f, 0x79,
+	0x6f, 0x75, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x69, 0x64, 0x59, 0x6f, 0x75, 0x4d, 0x65, 0x61, 0x6e, 0x22, 0x55, 0x0a, 0x18, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61,
+	0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65,
+	0x61, 0x73, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74,
+	0x22, 0x56, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0c, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65,
+	0x61, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x61, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x0b,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x1b, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcd, 0x04, 0x0a, 0x12, 0x54, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x6e, 0x61, 0x72,
+	0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6e, 0x61,
+	0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x12, 0x23, 0x2e,
+	0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x12, 0x23, 0x2e,
+	0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x12, 0x23, 0x2e,
+	0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x79, 0x2d, 0x67,
+	0x69, 0x6e, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6e, 0x61, 0x72, 0x75, 0x74,
+	0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x61, 0x72, 0x75, 0x74, 0x6f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_naruto_v1_tailed_beast_proto_rawDescOnce sync.Once
+	file_naruto_v1_tailed_beast_proto_rawDescData = file_naruto_v1_tailed_beast_proto_rawDesc
+)
+
+func file_naruto_v1_tailed_beast_proto_rawDescGZIP() []byte {
+	file_naruto_v1_tailed_beast_proto_rawDescOnce.Do(func() {
+		file_naruto_v1_tailed_beast_proto_rawDescData = protoimpl.X.CompressGZIP(file_naruto_v1_tailed_beast_proto_rawDescData)
+	})
+	return file_naruto_v1_tailed_beast_proto_rawDescData
+}
+
+var file_naruto_v1_tailed_beast_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_naruto_v1_tailed_beast_proto_goTypes = []any{
+	(*TailedBeast)(nil),                // 0: naruto.v1.TailedBeast
+	(*TailedBeastHit)(nil),             // 1: naruto.v1.TailedBeastHit
+	(*GetTailedBeastRequest)(nil),      // 2: naruto.v1.GetTailedBeastRequest
+	(*GetTailedBeastResponse)(nil),     // 3: naruto.v1.GetTailedBeastResponse
+	(*ListTailedBeastsRequest)(nil),    // 4: naruto.v1.ListTailedBeastsRequest
+	(*ListTailedBeastsResponse)(nil),   // 5: naruto.v1.ListTailedBeastsResponse
+	(*SearchTailedBeastsRequest)(nil),  // 6: naruto.v1.SearchTailedBeastsRequest
+	(*SearchTailedBeastsResponse)(nil), // 7: naruto.v1.SearchTailedBeastsResponse
+	(*CreateTailedBeastRequest)(nil),   // 8: naruto.v1.CreateTailedBeastRequest
+	(*CreateTailedBeastResponse)(nil),  // 9: naruto.v1.CreateTailedBeastResponse
+	(*UpdateTailedBeastRequest)(nil),   // 10: naruto.v1.UpdateTailedBeastRequest
+	(*UpdateTailedBeastResponse)(nil),  // 11: naruto.v1.UpdateTailedBeastResponse
+	(*DeleteTailedBeastRequest)(nil),   // 12: naruto.v1.DeleteTailedBeastRequest
+	(*DeleteTailedBeastResponse)(nil),  // 13: naruto.v1.DeleteTailedBeastResponse
+	nil,                                // 14: naruto.v1.ListTailedBeastsRequest.FiltersEntry
+	(*Highlight)(nil),                  // 15: naruto.v1.Highlight
+}
+var file_naruto_v1_tailed_beast_proto_depIdxs = []int32{
+	0,  // 0: naruto.v1.TailedBeastHit.tailed_beast:type_name -> naruto.v1.TailedBeast
+	15, // 1: naruto.v1.TailedBeastHit.highlights:type_name -> naruto.v1.Highlight
+	0,  // 2: naruto.v1.GetTailedBeastResponse.tailed_beast:type_name -> naruto.v1.TailedBeast
+	14, // 3: naruto.v1.ListTailedBeastsRequest.filters:type_name -> naruto.v1.ListTailedBeastsRequest.FiltersEntry
+	0,  // 4: naruto.v1.ListTailedBeastsResponse.tailed_beast:type_name -> naruto.v1.TailedBeast
+	1,  // 5: naruto.v1.SearchTailedBeastsResponse.hits:type_name -> naruto.v1.TailedBeastHit
+	0,  // 6: naruto.v1.CreateTailedBeastRequest.tailed_beast:type_name -> naruto.v1.TailedBeast
+	0,  // 7: naruto.v1.CreateTailedBeastResponse.tailed_beast:type_name -> naruto.v1.TailedBeast
+	0,  // 8: naruto.v1.UpdateTailedBeastRequest.tailed_beast:type_name -> naruto.v1.TailedBeast
+	0,  // 9: naruto.v1.UpdateTailedBeastResponse.tailed_beast:type_name -> naruto.v1.TailedBeast
+	2,  // 10: naruto.v1.TailedBeastService.GetTailedBeast:input_type -> naruto.v1.GetTailedBeastRequest
+	4,  // 11: naruto.v1.TailedBeastService.ListTailedBeasts:input_type -> naruto.v1.ListTailedBeastsRequest
+	6,  // 12: naruto.v1.TailedBeastService.SearchTailedBeasts:input_type -> naruto.v1.SearchTailedBeastsRequest
+	8,  // 13: naruto.v1.TailedBeastService.CreateTailedBeast:input_type -> naruto.v1.CreateTailedBeastRequest
+	10, // 14: naruto.v1.TailedBeastService.UpdateTailedBeast:input_type -> naruto.v1.UpdateTailedBeastRequest
+	12, // 15: naruto.v1.TailedBeastService.DeleteTailedBeast:input_type -> naruto.v1.DeleteTailedBeastRequest
+	3,  // 16: naruto.v1.TailedBeastService.GetTailedBeast:output_type -> naruto.v1.GetTailedBeastResponse
+	5,  // 17: naruto.v1.TailedBeastService.ListTailedBeasts:output_type -> naruto.v1.ListTailedBeastsResponse
+	7,  // 18: naruto.v1.TailedBeastService.SearchTailedBeasts:output_type -> naruto.v1.SearchTailedBeastsResponse
+	9,  // 19: naruto.v1.TailedBeastService.CreateTailedBeast:output_type -> naruto.v1.CreateTailedBeastResponse
+	11, // 20: naruto.v1.TailedBeastService.UpdateTailedBeast:output_type -> naruto.v1.UpdateTailedBeastResponse
+	13, // 21: naruto.v1.TailedBeastService.DeleteTailedBeast:output_type -> naruto.v1.DeleteTailedBeastResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_naruto_v1_tailed_beast_proto_init() }
+func file_naruto_v1_tailed_beast_proto_init() {
+	if File_naruto_v1_tailed_beast_proto != nil {
+		return
+	}
+	file_naruto_v1_search_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_naruto_v1_tailed_beast_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_naruto_v1_tailed_beast_proto_goTypes,
+		DependencyIndexes: file_naruto_v1_tailed_beast_proto_depIdxs,
+		MessageInfos:      file_naruto_v1_tailed_beast_proto_msgTypes,
+	}.Build()
+	File_naruto_v1_tailed_beast_proto = out.File
+	file_naruto_v1_tailed_beast_proto_rawDesc = nil
+	file_naruto_v1_tailed_beast_proto_goTypes = nil
+	file_naruto_v1_tailed_beast_proto_depIdxs = nil
+}
@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: naruto/v1/tailed_beast.proto
+
+package narutov1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TailedBeastService_GetTailedBeast_FullMethodName     = "/naruto.v1.TailedBeastService/GetTailedBeast"
+	TailedBeastService_ListTailedBeasts_FullMethodName   = "/naruto.v1.TailedBeastService/ListTailedBeasts"
+	TailedBeastService_SearchTailedBeasts_FullMethodName = "/naruto.v1.TailedBeastService/SearchTailedBeasts"
+	TailedBeastService_CreateTailedBeast_FullMethodName  = "/naruto.v1.TailedBeastService/CreateTailedBeast"
+	TailedBeastService_UpdateTailedBeast_FullMethodName  = "/naruto.v1.TailedBeastService/UpdateTailedBeast"
+	TailedBeastService_DeleteTailedBeast_FullMethodName  = "/naruto.v1.TailedBeastService/DeleteTailedBeast"
+)
+
+// TailedBeastServiceClient is the client API for TailedBeastService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TailedBeastService exposes the same operations as the /v1/tailedbeast REST endpoints.
+type TailedBeastServiceClient interface {
+	GetTailedBeast(ctx context.Context, in *GetTailedBeastRequest, opts ...grpc.CallOption) (*GetTailedBeastResponse, error)
+	// ListTailedBeasts streams every matching tailed beast; the total count is sent in the
+	// "x-total-count" response header.
+	ListTailedBeasts(ctx context.Context, in *ListTailedBeastsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListTailedBeastsResponse], error)
+	SearchTailedBeasts(ctx context.Context, in *SearchTailedBeastsRequest, opts ...grpc.CallOption) (*SearchTailedBeastsResponse, error)
+	CreateTailedBeast(ctx context.Context, in *CreateTailedBeastRequest, opts ...grpc.CallOption) (*CreateTailedBeastResponse, error)
+	UpdateTailedBeast(ctx context.Context, in *UpdateTailedBeastRequest, opts ...grpc.CallOption) (*UpdateTailedBeastResponse, error)
+	DeleteTailedBeast(ctx context.Context, in *DeleteTailedBeastRequest, opts ...grpc.CallOption) (*DeleteTailedBeastResponse, error)
+}
+
+type tailedBeastServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTailedBeastServiceClient(cc grpc.ClientConnInterface) TailedBeastServiceClient {
+	return &tailedBeastServiceClient{cc}
+}
+
+func (c *tailedBeastServiceClient) GetTailedBeast(ctx context.Context, in *GetTailedBeastRequest, opts ...grpc.CallOption) (*GetTailedBeastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTailedBeastResponse)
+	err := c.cc.Invoke(ctx, TailedBeastService_GetTailedBeast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tailedBeastServiceClient) ListTailedBeasts(ctx context.Context, in *ListTailedBeastsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListTailedBeastsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TailedBeastService_ServiceDesc.Streams[0], TailedBeastService_ListTailedBeasts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListTailedBeastsRequest, ListTailedBeastsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TailedBeastService_ListTailedBeastsClient = grpc.ServerStreamingClient[ListTailedBeastsResponse]
+
+func (c *tailedBeastServiceClient) SearchTailedBeasts(ctx context.Context, in *SearchTailedBeastsRequest, opts ...grpc.CallOption) (*SearchTailedBeastsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTailedBeastsResponse)
+	err := c.cc.Invoke(ctx, TailedBeastService_SearchTailedBeasts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tailedBeastServiceClient) CreateTailedBeast(ctx context.Context, in *CreateTailedBeastRequest, opts ...grpc.CallOption) (*CreateTailedBeastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTailedBeastResponse)
+	err := c.cc.Invoke(ctx, TailedBeastService_CreateTailedBeast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tailedBeastServiceClient) UpdateTailedBeast(ctx context.Context, in *UpdateTailedBeastRequest, opts ...grpc.CallOption) (*UpdateTailedBeastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTailedBeastResponse)
+	err := c.cc.Invoke(ctx, TailedBeastService_UpdateTailedBeast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tailedBeastServiceClient) DeleteTailedBeast(ctx context.Context, in *DeleteTailedBeastRequest, opts ...grpc.CallOption) (*DeleteTailedBeastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTailedBeastResponse)
+	err := c.cc.Invoke(ctx, TailedBeastService_DeleteTailedBeast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TailedBeastServiceServer is the server API for TailedBeastService service.
+// All implementations must embed UnimplementedTailedBeastServiceServer
+// for forward compatibility.
+//
+// TailedBeastService exposes the same operations as the /v1/tailedbeast REST endpoints.
+type TailedBeastServiceServer interface {
+	GetTailedBeast(context.Context, *GetTailedBeastRequest) (*GetTailedBeastResponse, error)
+	// ListTailedBeasts streams every matching tailed beast; the total count is sent in the
+	// "x-total-count" response header.
+	ListTailedBeasts(*ListTailedBeastsRequest, grpc.ServerStreamingServer[ListTailedBeastsResponse]) error
+	SearchTailedBeasts(context.Context, *SearchTailedBeastsRequest) (*SearchTailedBeastsResponse, error)
+	CreateTailedBeast(context.Context, *CreateTailedBeastRequest) (*CreateTailedBeastResponse, error)
+	UpdateTailedBeast(context.Context, *UpdateTailedBeastRequest) (*UpdateTailedBeastResponse, error)
+	DeleteTailedBeast(context.Context, *DeleteTailedBeastRequest) (*DeleteTailedBeastResponse, error)
+	mustEmbedUnimplementedTailedBeastServiceServer()
+}
+
+// UnimplementedTailedBeastServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTailedBeastServiceServer struct{}
+
+func (UnimplementedTailedBeastServiceServer) GetTailedBeast(context.Context, *GetTailedBeastRequest) (*GetTailedBeastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTailedBeast not implemented")
+}
+func (UnimplementedTailedBeastServiceServer) ListTailedBeasts(*ListTailedBeastsRequest, grpc.ServerStreamingServer[ListTailedBeastsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListTailedBeasts not implemented")
+}
+func (UnimplementedTailedBeastServiceServer) SearchTailedBeasts(context.Context, *SearchTailedBeastsRequest) (*SearchTailedBeastsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTailedBeasts not implemented")
+}
+func (UnimplementedTailedBeastServiceServer) CreateTailedBeast(context.Context, *CreateTailedBeastRequest) (*CreateTailedBeastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTailedBeast not implemented")
+}
+func (UnimplementedTailedBeastServiceServer) UpdateTailedBeast(context.Context, *UpdateTailedBeastRequest) (*UpdateTailedBeastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTailedBeast not implemented")
+}
+func (UnimplementedTailedBeastServiceServer) DeleteTailedBeast(context.Context, *DeleteTailedBeastRequest) (*DeleteTailedBeastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTailedBeast not implemented")
+}
+func (UnimplementedTailedBeastServiceServer) mustEmbedUnimplementedTailedBeastServiceServer() {}
+func (UnimplementedTailedBeastServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTailedBeastServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TailedBeastServiceServer will
+// result in compilation errors.
+type UnsafeTailedBeastServiceServer interface {
+	mustEmbedUnimplementedTailedBeastServiceServer()
+}
+
+func RegisterTailedBeastServiceServer(s grpc.ServiceRegistrar, srv TailedBeastServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTailedBeastServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TailedBeastService_ServiceDesc, srv)
+}
+
+func _TailedBeastService_GetTailedBeast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTailedBeastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TailedBeastServiceServer).GetTailedBeast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TailedBeastService_GetTailedBeast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TailedBeastServiceServer).GetTailedBeast(ctx, req.(*GetTailedBeastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TailedBeastService_ListTailedBeasts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTailedBeastsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TailedBeastServiceServer).ListTailedBeasts(m, &grpc.GenericServerStream[ListTailedBeastsRequest, ListTailedBeastsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TailedBeastService_ListTailedBeastsServer = grpc.ServerStreamingServer[ListTailedBeastsResponse]
+
+func _TailedBeastService_SearchTailedBeasts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTailedBeastsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TailedBeastServiceServer).SearchTailedBeasts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TailedBeastService_SearchTailedBeasts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TailedBeastServiceServer).SearchTailedBeasts(ctx, req.(*SearchTailedBeastsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TailedBeastService_CreateTailedBeast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTailedBeastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TailedBeastServiceServer).CreateTailedBeast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TailedBeastService_CreateTailedBeast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TailedBeastServiceServer).CreateTailedBeast(ctx, req.(*CreateTailedBeastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TailedBeastService_UpdateTailedBeast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTailedBeastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TailedBeastServiceServer).UpdateTailedBeast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TailedBeastService_UpdateTailedBeast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TailedBeastServiceServer).UpdateTailedBeast(ctx, req.(*UpdateTailedBeastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TailedBeastService_DeleteTailedBeast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTailedBeastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TailedBeastServiceServer).DeleteTailedBeast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TailedBeastService_DeleteTailedBeast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TailedBeastServiceServer).DeleteTailedBeast(ctx, req.(*DeleteTailedBeastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TailedBeastService_ServiceDesc is the grpc.ServiceDesc for TailedBeastService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TailedBeastService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "naruto.v1.TailedBeastService",
+	HandlerType: (*TailedBeastServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTailedBeast",
+			Handler:    _TailedBeastService_GetTailedBeast_Handler,
+		},
+		{
+			MethodName: "SearchTailedBeasts",
+			Handler:    _TailedBeastService_SearchTailedBeasts_Handler,
+		},
+		{
+			MethodName: "CreateTailedBeast",
+			Handler:    _TailedBeastService_CreateTailedBeast_Handler,
+		},
+		{
+			MethodName: "UpdateTailedBeast",
+			Handler:    _TailedBeastService_UpdateTailedBeast_Handler,
+		},
+		{
+			MethodName: "DeleteTailedBeast",
+			Handler:    _TailedBeastService_DeleteTailedBeast_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListTailedBeasts",
+			Handler:       _TailedBeastService_ListTailedBeasts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "naruto/v1/tailed_beast.proto",
+}
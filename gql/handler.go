@@ -0,0 +1,162 @@
+// Package gql menyajikan endpoint GraphQL /graphql di atas service yang sama dengan REST.
+// Schema diturunkan dari struct di models; relasi (jinchuriki → karakter) dimuat dengan DataLoader.
+package gql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	"my-gin-app/character"
+	"my-gin-app/response"
+	"my-gin-app/search"
+	"my-gin-app/tailedbeast"
+)
+
+// Request adalah body POST /graphql
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+type Handler struct {
+	Schema graphql.Schema
+	Limits Limits
+
+	resolver *resolver
+}
+
+func NewHandler(characters character.Service, tailedBeasts tailedbeast.Service, engine *search.Engine) (*Handler, error) {
+	r := &resolver{
+		characters:   characters,
+		tailedBeasts: tailedBeasts,
+		engine:       engine,
+	}
+	schema, err := newSchema(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{
+		Schema:   schema,
+		Limits:   DefaultLimits,
+		resolver: r,
+	}, nil
+}
+
+// Query handler untuk GET /graphql?query=...&variables=...; hanya untuk query, bukan mutation
+func (h *Handler) Query(c *gin.Context) {
+	req := Request{
+		Query:         c.Query("query"),
+		OperationName: c.Query("operationName"),
+	}
+	if vars := c.Query("variables"); vars != "" {
+		if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+			fail(c, http.StatusBadRequest, fmt.Errorf("invalid variables: %v", err))
+			return
+		}
+	}
+	h.execute(c, req, false)
+}
+
+// Execute handler untuk POST /graphql dengan body {"query", "operationName", "variables"}
+func (h *Handler) Execute(c *gin.Context) {
+	var req Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		fail(c, http.StatusBadRequest, err)
+		return
+	}
+	h.execute(c, req, true)
+}
+
+func (h *Handler) execute(c *gin.Context, req Request, allowMutation bool) {
+	if req.Query == "" {
+		fail(c, http.StatusBadRequest, errors.New("query is required"))
+		return
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		fail(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if result := graphql.ValidateDocument(&h.Schema, doc, graphql.SpecifiedRules); !result.IsValid {
+		c.JSON(http.StatusBadRequest, &graphql.Result{Errors: result.Errors})
+		return
+	}
+
+	op, fragments, err := operation(doc, req.OperationName)
+	if err != nil {
+		fail(c, http.StatusBadRequest, err)
+		return
+	}
+	if op.Operation == ast.OperationTypeMutation && !allowMutation {
+		fail(c, http.StatusMethodNotAllowed, errors.New("mutations must use POST"))
+		return
+	}
+	if err := h.Limits.check(op, fragments, req.Variables); err != nil {
+		fail(c, http.StatusBadRequest, err)
+		return
+	}
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.Schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       h.resolver.withLoaders(c.Request.Context()),
+	})
+	// resolver yang gagal karena deadline atau client yang memutus koneksi tidak dilaporkan sebagai hasil parsial
+	if status, detail, ok := response.ContextStatus(c.Request.Context()); ok {
+		fail(c, status, errors.New(detail))
+		return
+	}
+	for _, e := range result.Errors {
+		_ = c.Error(e)
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// operation memilih operasi yang dijalankan dan mengumpulkan fragment untuk perhitungan limit
+func operation(doc *ast.Document, name string) (*ast.OperationDefinition, map[string]*ast.FragmentDefinition, error) {
+	var ops []*ast.OperationDefinition
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.OperationDefinition:
+			ops = append(ops, d)
+		case *ast.FragmentDefinition:
+			fragments[d.Name.Value] = d
+		}
+	}
+
+	if name == "" {
+		if len(ops) != 1 {
+			return nil, nil, errors.New("operationName is required when the document has several operations")
+		}
+		return ops[0], fragments, nil
+	}
+	for _, op := range ops {
+		if op.Name != nil && op.Name.Value == name {
+			return op, fragments, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("unknown operation %s", name)
+}
+
+// fail menulis error dalam format respons GraphQL ({"errors": [...]})
+func fail(c *gin.Context, status int, err error) {
+	_ = c.Error(err)
+	c.JSON(status, &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)}})
+}
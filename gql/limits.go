@@ -0,0 +1,131 @@
+package gql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	// defaultListSize dipakai sebagai pengali kompleksitas field list yang tidak memakai argumen limit
+	defaultListSize = 10
+	// maxListSize adalah limit terbesar yang benar-benar dijalankan resolver, sama dengan batas limit REST /suggest
+	maxListSize = 50
+)
+
+// Limits membatasi bentuk query sebelum dieksekusi supaya satu request tidak bisa membebani MongoDB
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+var DefaultLimits = Limits{
+	MaxDepth:      8,
+	MaxComplexity: 1000,
+}
+
+// listFields adalah field yang mengembalikan banyak objek; kompleksitas anaknya dikali limit
+var listFields = map[string]bool{
+	"characters":   true,
+	"tailedBeasts": true,
+	"search":       true,
+}
+
+// check menghitung kedalaman dan kompleksitas operasi. Field introspeksi (__schema, __type) tidak dihitung.
+func (l Limits) check(op *ast.OperationDefinition, fragments map[string]*ast.FragmentDefinition, vars map[string]interface{}) error {
+	w := walker{fragments: fragments, vars: vars, defaults: map[string]ast.Value{}, visiting: map[string]bool{}}
+	// variabel yang tidak dikirim memakai default dari deklarasinya, misal ($n: Int = 100)
+	for _, def := range op.VariableDefinitions {
+		if def.DefaultValue != nil {
+			w.defaults[def.Variable.Name.Value] = def.DefaultValue
+		}
+	}
+	depth, complexity := w.selectionSet(op.SelectionSet, 0)
+
+	if l.MaxDepth > 0 && depth > l.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds the limit of %d", depth, l.MaxDepth)
+	}
+	if l.MaxComplexity > 0 && complexity > l.MaxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the limit of %d", complexity, l.MaxComplexity)
+	}
+	return nil
+}
+
+type walker struct {
+	fragments map[string]*ast.FragmentDefinition
+	vars      map[string]interface{}
+	defaults  map[string]ast.Value
+	visiting  map[string]bool
+}
+
+// selectionSet mengembalikan kedalaman maksimum dan total kompleksitas selection set
+func (w walker) selectionSet(set *ast.SelectionSet, depth int) (int, int) {
+	if set == nil {
+		return depth, 0
+	}
+
+	maxDepth, complexity := depth, 0
+	for _, sel := range set.Selections {
+		var d, c int
+		switch s := sel.(type) {
+		case *ast.Field:
+			if len(s.Name.Value) > 1 && s.Name.Value[:2] == "__" {
+				continue
+			}
+			d, c = w.selectionSet(s.SelectionSet, depth+1)
+			if listFields[s.Name.Value] {
+				c *= w.listSize(s)
+			}
+			c++
+		case *ast.InlineFragment:
+			d, c = w.selectionSet(s.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			name := s.Name.Value
+			frag, ok := w.fragments[name]
+			if !ok || w.visiting[name] {
+				continue
+			}
+			w.visiting[name] = true
+			d, c = w.selectionSet(frag.SelectionSet, depth)
+			delete(w.visiting, name)
+		}
+		if d > maxDepth {
+			maxDepth = d
+		}
+		complexity += c
+	}
+	return maxDepth, complexity
+}
+
+// listSize membaca argumen limit (literal, variabel, atau default variabel) dari field list
+func (w walker) listSize(f *ast.Field) int {
+	for _, arg := range f.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+		value := arg.Value
+		if v, ok := value.(*ast.Variable); ok {
+			if n, ok := w.vars[v.Name.Value]; ok {
+				switch n := n.(type) {
+				case float64:
+					if n > 0 {
+						return int(n)
+					}
+				case int:
+					if n > 0 {
+						return n
+					}
+				}
+				return defaultListSize
+			}
+			value = w.defaults[v.Name.Value]
+		}
+		if v, ok := value.(*ast.IntValue); ok {
+			if n, err := strconv.Atoi(v.Value); err == nil && n > 0 {
+				return n
+			}
+		}
+	}
+	return defaultListSize
+}
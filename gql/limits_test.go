@@ -0,0 +1,126 @@
+package gql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+func checkQuery(t *testing.T, limits Limits, query string, vars map[string]interface{}) error {
+	t.Helper()
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query)})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, fragments, err := operation(doc, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return limits.check(op, fragments, vars)
+}
+
+func TestLimitsCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits Limits
+		query  string
+		vars   map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "depth within limit",
+			limits: Limits{MaxDepth: 3},
+			query:  `{ character(slug: "naruto") { tailedBeasts { name } } }`,
+		},
+		{
+			name:   "depth over limit",
+			limits: Limits{MaxDepth: 3},
+			query:  `{ character(slug: "naruto") { tailedBeasts { host { name } } } }`,
+			err:    "query depth 4 exceeds the limit of 3",
+		},
+		{
+			// characters: (items: (name + slug) + 1) * 50 + 1 = 151
+			name:   "complexity multiplies list children by the limit argument",
+			limits: Limits{MaxComplexity: 150},
+			query:  `{ characters(page: 1, limit: 50) { items { name slug } } }`,
+			err:    "query complexity 151 exceeds the limit of 150",
+		},
+		{
+			name:   "limit from a variable",
+			limits: Limits{MaxComplexity: 150},
+			query:  `query ($n: Int) { characters(page: 1, limit: $n) { items { name slug } } }`,
+			vars:   map[string]interface{}{"n": float64(50)},
+			err:    "query complexity 151 exceeds the limit of 150",
+		},
+		{
+			// variabel yang tidak dikirim dihitung dengan nilai default-nya, bukan defaultListSize
+			name:   "limit from a variable default",
+			limits: DefaultLimits,
+			query:  `query ($n: Int = 100000) { characters(limit: $n) { items { name } } }`,
+			err:    "query complexity 200001 exceeds the limit of 1000",
+		},
+		{
+			name:   "supplied variable wins over its default",
+			limits: DefaultLimits,
+			query:  `query ($n: Int = 100000) { characters(limit: $n) { items { name } } }`,
+			vars:   map[string]interface{}{"n": float64(5)},
+		},
+		{
+			// tanpa limit list dihitung sebagai defaultListSize: (1 + 1) * 10 + 1 = 21
+			name:   "omitted limit uses the default page size",
+			limits: Limits{MaxComplexity: 20},
+			query:  `{ characters { items { name } } }`,
+			err:    "query complexity 21 exceeds the limit of 20",
+		},
+		{
+			name:   "fragments count like inline selections",
+			limits: Limits{MaxDepth: 2},
+			query:  `{ character(slug: "naruto") { ...names } } fragment names on Character { tailedBeasts { name } }`,
+			err:    "query depth 3 exceeds the limit of 2",
+		},
+		{
+			name:   "fragment cycles terminate",
+			limits: DefaultLimits,
+			query:  `{ ...a } fragment a on Query { character(slug: "x") { name } ...b } fragment b on Query { ...a }`,
+		},
+		{
+			name:   "introspection is not counted",
+			limits: Limits{MaxDepth: 1, MaxComplexity: 1},
+			query:  `{ __schema { types { name fields { name type { name } } } } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkQuery(t, tt.limits, tt.query, tt.vars)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("unexpected error %v", err)
+			case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+				t.Fatalf("error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestPageArgsCapsLimit(t *testing.T) {
+	tests := []struct {
+		args        map[string]interface{}
+		page, limit int
+	}{
+		{map[string]interface{}{}, 1, defaultListSize},
+		{map[string]interface{}{"page": 3, "limit": 20}, 3, 20},
+		{map[string]interface{}{"limit": 100000}, 1, maxListSize},
+	}
+	for _, tt := range tests {
+		pg, limit, err := pageArgs(tt.args)
+		if err != nil || pg != tt.page || limit != tt.limit {
+			t.Errorf("pageArgs(%v) = %d, %d, %v; want %d, %d", tt.args, pg, limit, err, tt.page, tt.limit)
+		}
+	}
+	if _, _, err := pageArgs(map[string]interface{}{"limit": -1}); err == nil {
+		t.Error("negative limit accepted")
+	}
+}
@@ -0,0 +1,80 @@
+package gql
+
+import "sync"
+
+// Loader mengumpulkan key yang diminta resolver lalu mengambilnya dalam satu batch (pola DataLoader).
+// Resolver mengembalikan thunk dari Load; graphql-go menjalankan thunk setelah satu level selesai,
+// sehingga semua key di level itu sudah terkumpul saat thunk pertama dipanggil. Loader berumur satu request.
+type Loader[V any] struct {
+	fetch func(keys []string) (map[string]V, error)
+
+	mu      sync.Mutex
+	pending []string
+	loaded  map[string]bool
+	cache   map[string]V
+	errs    map[string]error
+}
+
+func NewLoader[V any](fetch func(keys []string) (map[string]V, error)) *Loader[V] {
+	return &Loader[V]{
+		fetch:  fetch,
+		loaded: map[string]bool{},
+		cache:  map[string]V{},
+		errs:   map[string]error{},
+	}
+}
+
+// Load mendaftarkan key dan mengembalikan thunk yang menghasilkan nilainya (nil jika tidak ada)
+func (l *Loader[V]) Load(key string) func() (interface{}, error) {
+	l.mu.Lock()
+	if !l.loaded[key] {
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		l.dispatch()
+		if err, ok := l.errs[key]; ok {
+			return nil, err
+		}
+		if v, ok := l.cache[key]; ok {
+			return v, nil
+		}
+		return nil, nil
+	}
+}
+
+// dispatch mengambil semua key yang tertunda dalam satu panggilan fetch
+func (l *Loader[V]) dispatch() {
+	if len(l.pending) == 0 {
+		return
+	}
+
+	seen := map[string]bool{}
+	var keys []string
+	for _, k := range l.pending {
+		if !l.loaded[k] && !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	l.pending = nil
+	if len(keys) == 0 {
+		return
+	}
+
+	values, err := l.fetch(keys)
+	for _, k := range keys {
+		l.loaded[k] = true
+		if err != nil {
+			l.errs[k] = err
+			continue
+		}
+		if v, ok := values[k]; ok {
+			l.cache[k] = v
+		}
+	}
+}
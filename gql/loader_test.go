@@ -0,0 +1,84 @@
+package gql
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// recorder mencatat setiap batch yang diminta loader
+type recorder struct {
+	batches [][]string
+	err     error
+}
+
+func (r *recorder) fetch(keys []string) (map[string]string, error) {
+	batch := append([]string{}, keys...)
+	sort.Strings(batch)
+	r.batches = append(r.batches, batch)
+	if r.err != nil {
+		return nil, r.err
+	}
+	values := map[string]string{}
+	for _, k := range keys {
+		if k != "missing" {
+			values[k] = "value of " + k
+		}
+	}
+	return values, nil
+}
+
+func TestLoaderBatchesAndDedups(t *testing.T) {
+	rec := &recorder{}
+	l := NewLoader(rec.fetch)
+
+	thunks := map[string]func() (interface{}, error){}
+	for _, key := range []string{"naruto", "sasuke", "naruto", "missing"} {
+		thunks[key] = l.Load(key)
+	}
+
+	for key, thunk := range thunks {
+		v, err := thunk()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key == "missing" {
+			if v != nil {
+				t.Errorf("missing key returned %v", v)
+			}
+			continue
+		}
+		if v != "value of "+key {
+			t.Errorf("%s = %v", key, v)
+		}
+	}
+	if want := [][]string{{"missing", "naruto", "sasuke"}}; !reflect.DeepEqual(rec.batches, want) {
+		t.Fatalf("batches = %v, want %v", rec.batches, want)
+	}
+
+	// key yang sudah dimuat (termasuk yang tidak ada) tidak diambil lagi; key baru masuk batch berikutnya
+	l.Load("naruto")
+	l.Load("missing")
+	if v, _ := l.Load("kakashi")(); v != "value of kakashi" {
+		t.Errorf("kakashi = %v", v)
+	}
+	if want := []string{"kakashi"}; len(rec.batches) != 2 || !reflect.DeepEqual(rec.batches[1], want) {
+		t.Fatalf("batches = %v, want second batch %v", rec.batches, want)
+	}
+}
+
+func TestLoaderErrorReachesEveryKey(t *testing.T) {
+	rec := &recorder{err: errors.New("mongo down")}
+	l := NewLoader(rec.fetch)
+
+	a, b := l.Load("naruto"), l.Load("sasuke")
+	for _, thunk := range []func() (interface{}, error){a, b} {
+		if _, err := thunk(); err == nil || err.Error() != "mongo down" {
+			t.Errorf("err = %v", err)
+		}
+	}
+	if len(rec.batches) != 1 {
+		t.Errorf("fetched %d times, want 1", len(rec.batches))
+	}
+}
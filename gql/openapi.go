@@ -0,0 +1,27 @@
+package gql
+
+import (
+	"net/http"
+
+	"my-gin-app/openapi"
+)
+
+// Operations mendokumentasikan route GraphQL untuk dokumen OpenAPI; schema lengkap tersedia lewat introspeksi
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/graphql", Tag: "graphql",
+			Summary: "Run a GraphQL query",
+			Query: []openapi.Param{
+				{Name: "query", Required: true, Description: "GraphQL query document"},
+				{Name: "operationName", Description: "Operation to run when the document has several"},
+				{Name: "variables", Description: "JSON encoded variables"},
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/graphql", Tag: "graphql",
+			Summary: "Run a GraphQL query or mutation",
+			Body:    Request{},
+		},
+	}
+}
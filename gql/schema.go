@@ -0,0 +1,429 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/gosimple/slug"
+	"github.com/graphql-go/graphql"
+
+	"my-gin-app/character"
+	"my-gin-app/models"
+	"my-gin-app/search"
+	"my-gin-app/tailedbeast"
+)
+
+// resolver menghubungkan schema GraphQL ke service yang sama dengan REST
+type resolver struct {
+	characters   character.Service
+	tailedBeasts tailedbeast.Service
+	engine       *search.Engine
+}
+
+// page adalah hasil query list beserta informasi pagination
+type page struct {
+	Items      interface{} `json:"items"`
+	Page       int         `json:"page"`
+	Limit      int         `json:"limit"`
+	TotalPages int64       `json:"totalPages"`
+	TotalItems int64       `json:"totalItems"`
+}
+
+type loaders struct {
+	characters   *Loader[models.Character]
+	tailedBeasts *Loader[models.TailedBeast]
+	// hosted memuat tailed beast per slug jinchuriki (relasi Character.tailedBeasts)
+	hosted *Loader[[]models.TailedBeast]
+}
+
+type loadersKey struct{}
+
+// withLoaders memasang loader baru untuk satu request; cache loader tidak dibagi antar request
+func (r *resolver) withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		characters: NewLoader(func(slugs []string) (map[string]models.Character, error) {
+			found, err := r.characters.GetCharactersBySlugs(ctx, slugs)
+			if err != nil {
+				return nil, err
+			}
+			bySlug := make(map[string]models.Character, len(found))
+			for _, c := range found {
+				bySlug[c.Slug] = c
+			}
+			return bySlug, nil
+		}),
+		tailedBeasts: NewLoader(func(slugs []string) (map[string]models.TailedBeast, error) {
+			found, err := r.tailedBeasts.GetBeastsBySlugs(ctx, slugs)
+			if err != nil {
+				return nil, err
+			}
+			bySlug := make(map[string]models.TailedBeast, len(found))
+			for _, b := range found {
+				bySlug[b.Slug] = b
+			}
+			return bySlug, nil
+		}),
+		hosted: NewLoader(func(slugs []string) (map[string][]models.TailedBeast, error) {
+			found, err := r.tailedBeasts.GetBeastsByJinchuriki(ctx, slugs)
+			if err != nil {
+				return nil, err
+			}
+			byHost := make(map[string][]models.TailedBeast, len(slugs))
+			for _, b := range found {
+				byHost[b.Jinchuriki] = append(byHost[b.Jinchuriki], b)
+			}
+			return byHost, nil
+		}),
+	})
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// newSchema membangun schema dari struct di models; relasi dan query ditambahkan di sini
+func newSchema(r *resolver) (graphql.Schema, error) {
+	types := newTypeRegistry()
+
+	characterType := types.object(models.Character{}, nil)
+	tailedBeastType := types.object(models.TailedBeast{}, graphql.Fields{
+		"host": &graphql.Field{
+			Type:        characterType,
+			Description: "The jinchuriki character hosting this tailed beast",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				beast, _ := p.Source.(models.TailedBeast)
+				if beast.Jinchuriki == "" {
+					return nil, nil
+				}
+				return loadersFrom(p.Context).characters.Load(beast.Jinchuriki), nil
+			},
+		},
+	})
+
+	// ditambahkan setelah TailedBeast ada karena kedua type saling mereferensikan
+	characterType.AddFieldConfig("tailedBeasts", &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tailedBeastType))),
+		Description: "Tailed beasts hosted by this character (jinchuriki)",
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			c, _ := p.Source.(models.Character)
+			load := loadersFrom(p.Context).hosted.Load(c.Slug)
+			return func() (interface{}, error) {
+				beasts, err := load()
+				if err != nil || beasts != nil {
+					return beasts, err
+				}
+				return []models.TailedBeast{}, nil
+			}, nil
+		},
+	})
+
+	characterPage := pageType("CharacterPage", characterType)
+	tailedBeastPage := pageType("TailedBeastPage", tailedBeastType)
+	characterFilter := filterType("CharacterFilter", character.FacetFields)
+	tailedBeastFilter := filterType("TailedBeastFilter", tailedbeast.FacetFields)
+
+	searchResult := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SearchResult",
+		Fields: graphql.Fields{
+			"type":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"slug":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"name":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"score": &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"character": &graphql.Field{
+				Type: characterType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if hit, ok := p.Source.(search.Result).Data.(models.CharacterHit); ok {
+						return hit.Character, nil
+					}
+					return nil, nil
+				},
+			},
+			"tailedBeast": &graphql.Field{
+				Type: tailedBeastType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if hit, ok := p.Source.(search.Result).Data.(models.TailedBeastHit); ok {
+						return hit.TailedBeast, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	listArgs := func(filter *graphql.InputObject) graphql.FieldConfigArgument {
+		return graphql.FieldConfigArgument{
+			"page":   &graphql.ArgumentConfig{Type: graphql.Int, Description: "Page number (default 1)"},
+			"limit":  &graphql.ArgumentConfig{Type: graphql.Int, Description: "Page size (default 10, at most 50)"},
+			"filter": &graphql.ArgumentConfig{Type: filter},
+		}
+	}
+	slugArg := graphql.FieldConfigArgument{
+		"slug": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"character": &graphql.Field{
+				Type: characterType,
+				Args: slugArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFrom(p.Context).characters.Load(p.Args["slug"].(string)), nil
+				},
+			},
+			"characters": &graphql.Field{
+				Type: graphql.NewNonNull(characterPage),
+				Args: listArgs(characterFilter),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pg, limit, err := pageArgs(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					items, count, err := r.characters.ListCharacters(p.Context, pg, limit, filterArgs(p.Args, character.FacetFields))
+					if err != nil {
+						return nil, err
+					}
+					return newPage(items, pg, limit, count), nil
+				},
+			},
+			"tailedBeast": &graphql.Field{
+				Type: tailedBeastType,
+				Args: slugArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFrom(p.Context).tailedBeasts.Load(p.Args["slug"].(string)), nil
+				},
+			},
+			"tailedBeasts": &graphql.Field{
+				Type: graphql.NewNonNull(tailedBeastPage),
+				Args: listArgs(tailedBeastFilter),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pg, limit, err := pageArgs(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					items, count, err := r.tailedBeasts.ListBeasts(p.Context, pg, limit, filterArgs(p.Args, tailedbeast.FacetFields))
+					if err != nil {
+						return nil, err
+					}
+					return newPage(items, pg, limit, count), nil
+				},
+			},
+			"search": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(searchResult))),
+				Description: "Search every resource at once, like GET /search",
+				Args: graphql.FieldConfigArgument{
+					"q":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"types": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+					"limit": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 10, Description: "Results per type (at most 50)"},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var types []string
+					if list, ok := p.Args["types"].([]interface{}); ok {
+						for _, t := range list {
+							types = append(types, t.(string))
+						}
+					}
+					limit := p.Args["limit"].(int)
+					if limit < 1 {
+						return nil, errors.New("invalid limit number")
+					}
+					limits := map[string]int{}
+					for _, t := range r.engine.Types() {
+						limits[t] = min(limit, maxListSize)
+					}
+					results, _, err := r.engine.Search(p.Context, p.Args["q"].(string), types, limits)
+					if err != nil {
+						return nil, err
+					}
+					return results, nil
+				},
+			},
+		},
+	})
+
+	characterInput := types.input(models.Character{})
+	tailedBeastInput := types.input(models.TailedBeast{})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createCharacter": &graphql.Field{
+				Type: characterType,
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(characterInput)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var c models.Character
+					if err := decodeInput(p.Args["input"], &c); err != nil {
+						return nil, err
+					}
+					if err := r.characters.CreateCharacter(p.Context, &c); err != nil {
+						return nil, err
+					}
+					return c, nil
+				},
+			},
+			"updateCharacter": &graphql.Field{
+				Type: characterType,
+				Args: graphql.FieldConfigArgument{
+					"slug":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(characterInput)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var c models.Character
+					if err := decodeInput(p.Args["input"], &c); err != nil {
+						return nil, err
+					}
+					slugParam := p.Args["slug"].(string)
+					if err := r.characters.UpdateCharacter(p.Context, slugParam, &c); err != nil {
+						return nil, err
+					}
+					updated, err := r.characters.GetCharacterBySlug(p.Context, newSlug(slugParam, c.Name))
+					if err != nil {
+						return nil, err
+					}
+					return *updated, nil
+				},
+			},
+			"deleteCharacter": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: slugArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if err := r.characters.DeleteCharacter(p.Context, p.Args["slug"].(string)); err != nil {
+						return false, err
+					}
+					return true, nil
+				},
+			},
+			"createTailedBeast": &graphql.Field{
+				Type: tailedBeastType,
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(tailedBeastInput)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var b models.TailedBeast
+					if err := decodeInput(p.Args["input"], &b); err != nil {
+						return nil, err
+					}
+					if err := r.tailedBeasts.CreateBeast(p.Context, &b); err != nil {
+						return nil, err
+					}
+					return b, nil
+				},
+			},
+			"updateTailedBeast": &graphql.Field{
+				Type: tailedBeastType,
+				Args: graphql.FieldConfigArgument{
+					"slug":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(tailedBeastInput)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var b models.TailedBeast
+					if err := decodeInput(p.Args["input"], &b); err != nil {
+						return nil, err
+					}
+					slugParam := p.Args["slug"].(string)
+					if err := r.tailedBeasts.UpdateBeast(p.Context, slugParam, &b); err != nil {
+						return nil, err
+					}
+					updated, err := r.tailedBeasts.GetBeastBySlug(p.Context, newSlug(slugParam, b.Name))
+					if err != nil {
+						return nil, err
+					}
+					return *updated, nil
+				},
+			},
+			"deleteTailedBeast": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: slugArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if err := r.tailedBeasts.DeleteBeast(p.Context, p.Args["slug"].(string)); err != nil {
+						return false, err
+					}
+					return true, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query, Mutation: mutation})
+}
+
+func pageType(name string, item *graphql.Object) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.Fields{
+			"items":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(item)))},
+			"page":       &graphql.Field{Type: graphql.Int},
+			"limit":      &graphql.Field{Type: graphql.Int},
+			"totalPages": &graphql.Field{Type: graphql.Int},
+			"totalItems": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+}
+
+// filterType membuat input filter exact-match dari daftar facet resource
+func filterType(name string, facets map[string]string) *graphql.InputObject {
+	fields := graphql.InputObjectConfigFieldMap{}
+	for facet := range facets {
+		fields[filterName(facet)] = &graphql.InputObjectFieldConfig{Type: graphql.String}
+	}
+	return graphql.NewInputObject(graphql.InputObjectConfig{Name: name, Fields: fields})
+}
+
+// filterArgs menerjemahkan argumen filter kembali ke nama facet yang dipahami service
+func filterArgs(args map[string]interface{}, facets map[string]string) map[string]string {
+	filters := map[string]string{}
+	input, _ := args["filter"].(map[string]interface{})
+	names := make([]string, 0, len(facets))
+	for facet := range facets {
+		names = append(names, facet)
+	}
+	sort.Strings(names)
+	for _, facet := range names {
+		if v, ok := input[filterName(facet)].(string); ok && v != "" {
+			filters[facet] = v
+		}
+	}
+	return filters
+}
+
+// pageArgs selalu menghasilkan halaman: tanpa limit, service akan memuat seluruh collection
+// dan kompleksitas query (yang menghitung list sebagai defaultListSize) tidak lagi berarti.
+// Limit dibatasi maxListSize supaya tidak ada satu resolver yang memuat terlalu banyak dokumen.
+func pageArgs(args map[string]interface{}) (int, int, error) {
+	pg, _ := args["page"].(int)
+	limit, _ := args["limit"].(int)
+	if pg < 0 {
+		return 0, 0, errors.New("invalid page number")
+	}
+	if limit < 0 {
+		return 0, 0, errors.New("invalid limit number")
+	}
+	if pg == 0 {
+		pg = 1
+	}
+	if limit == 0 {
+		limit = defaultListSize
+	}
+	return pg, min(limit, maxListSize), nil
+}
+
+func newPage(items interface{}, pg, limit int, count int64) page {
+	p := page{Items: items, TotalItems: count}
+	if pg > 0 && limit > 0 {
+		p.Page = pg
+		p.Limit = limit
+		p.TotalPages = (count + int64(limit) - 1) / int64(limit)
+	}
+	return p
+}
+
+// newSlug mengikuti aturan UpdateCharacter/UpdateBeast: slug berubah hanya jika nama diisi
+func newSlug(current, name string) string {
+	if name == "" {
+		return current
+	}
+	return slug.Make(name)
+}
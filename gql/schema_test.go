@@ -0,0 +1,76 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+
+	"my-gin-app/character"
+	"my-gin-app/models"
+	"my-gin-app/search"
+	"my-gin-app/tailedbeast"
+)
+
+// fakeCharacters hanya mengimplementasikan method yang dipakai test; method lain panic lewat interface nil
+type fakeCharacters struct {
+	character.Service
+	limit int
+}
+
+func (f *fakeCharacters) ListCharacters(ctx context.Context, page int, limit int, filters map[string]string, fields ...string) ([]models.Character, int64, error) {
+	f.limit = limit
+	return []models.Character{{Name: "Naruto Uzumaki", Slug: "naruto-uzumaki"}, {Name: "Gaara", Slug: "gaara"}, {Name: "Sakura Haruno", Slug: "sakura-haruno"}}, 3, nil
+}
+
+type fakeBeasts struct {
+	tailedbeast.Service
+	calls [][]string
+}
+
+func (f *fakeBeasts) GetBeastsByJinchuriki(ctx context.Context, characterSlugs []string) ([]models.TailedBeast, error) {
+	keys := append([]string{}, characterSlugs...)
+	sort.Strings(keys)
+	f.calls = append(f.calls, keys)
+	return []models.TailedBeast{
+		{Name: "Kurama", Slug: "kurama", Jinchuriki: "naruto-uzumaki"},
+		{Name: "Shukaku", Slug: "shukaku", Jinchuriki: "gaara"},
+	}, nil
+}
+
+func TestSchemaResolvesTailedBeastsInOneBatch(t *testing.T) {
+	characters, beasts := &fakeCharacters{}, &fakeBeasts{}
+	r := &resolver{characters: characters, tailedBeasts: beasts, engine: search.NewEngine()}
+	schema, err := newSchema(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ characters { limit items { slug tailedBeasts { name } } } }`,
+		Context:       r.withLoaders(context.Background()),
+	})
+	if len(result.Errors) > 0 {
+		t.Fatal(result.Errors)
+	}
+
+	if characters.limit != defaultListSize {
+		t.Errorf("ListCharacters limit = %d, want %d", characters.limit, defaultListSize)
+	}
+	if want := [][]string{{"gaara", "naruto-uzumaki", "sakura-haruno"}}; !reflect.DeepEqual(beasts.calls, want) {
+		t.Errorf("GetBeastsByJinchuriki calls = %v, want %v", beasts.calls, want)
+	}
+
+	got, _ := json.Marshal(result.Data)
+	want := `{"characters":{"items":[` +
+		`{"slug":"naruto-uzumaki","tailedBeasts":[{"name":"Kurama"}]},` +
+		`{"slug":"gaara","tailedBeasts":[{"name":"Shukaku"}]},` +
+		`{"slug":"sakura-haruno","tailedBeasts":[]}],"limit":10}}`
+	if string(got) != want {
+		t.Errorf("data = %s\nwant   %s", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package gql
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+// typeRegistry menurunkan tipe GraphQL dari struct model berdasarkan tag json.
+// Setiap struct hanya dibuat sekali karena graphql-go menolak dua tipe dengan nama yang sama.
+type typeRegistry struct {
+	objects map[string]*graphql.Object
+	inputs  map[string]*graphql.InputObject
+}
+
+func newTypeRegistry() *typeRegistry {
+	return &typeRegistry{
+		objects: map[string]*graphql.Object{},
+		inputs:  map[string]*graphql.InputObject{},
+	}
+}
+
+// object membuat object type dari struct; extra menambah field yang tidak ada di model (misal relasi)
+func (r *typeRegistry) object(model interface{}, extra graphql.Fields) *graphql.Object {
+	t := reflect.TypeOf(model)
+	if obj, ok := r.objects[t.Name()]; ok {
+		return obj
+	}
+
+	fields := graphql.Fields{}
+	eachField(t, func(name string, ft reflect.Type) {
+		fields[name] = &graphql.Field{Type: r.output(ft)}
+	})
+	for name, f := range extra {
+		fields[name] = f
+	}
+
+	obj := graphql.NewObject(graphql.ObjectConfig{Name: t.Name(), Fields: fields})
+	r.objects[t.Name()] = obj
+	return obj
+}
+
+// input membuat input object type dari struct, misal CharacterInput untuk mutation
+func (r *typeRegistry) input(model interface{}) *graphql.InputObject {
+	return r.inputOf(reflect.TypeOf(model))
+}
+
+func (r *typeRegistry) inputOf(t reflect.Type) *graphql.InputObject {
+	name := t.Name() + "Input"
+	if in, ok := r.inputs[name]; ok {
+		return in
+	}
+
+	fields := graphql.InputObjectConfigFieldMap{}
+	eachField(t, func(fieldName string, ft reflect.Type) {
+		fields[fieldName] = &graphql.InputObjectFieldConfig{Type: r.inputField(ft)}
+	})
+
+	in := graphql.NewInputObject(graphql.InputObjectConfig{Name: name, Fields: fields})
+	r.inputs[name] = in
+	return in
+}
+
+func (r *typeRegistry) output(t reflect.Type) graphql.Output {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return r.output(t.Elem())
+	case reflect.Slice, reflect.Array:
+		return graphql.NewList(r.output(t.Elem()))
+	case reflect.Struct:
+		return r.object(reflect.Zero(t).Interface(), nil)
+	}
+	return scalar(t)
+}
+
+func (r *typeRegistry) inputField(t reflect.Type) graphql.Input {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return r.inputField(t.Elem())
+	case reflect.Slice, reflect.Array:
+		return graphql.NewList(r.inputField(t.Elem()))
+	case reflect.Struct:
+		return r.inputOf(t)
+	}
+	return scalar(t)
+}
+
+func scalar(t reflect.Type) *graphql.Scalar {
+	switch t.Kind() {
+	case reflect.Bool:
+		return graphql.Boolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return graphql.Int
+	case reflect.Float32, reflect.Float64:
+		return graphql.Float
+	}
+	return graphql.String
+}
+
+// eachField memanggil fn untuk setiap field ber-tag json, termasuk field dari struct embedded
+func eachField(t reflect.Type, fn func(name string, ft reflect.Type)) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.Anonymous && name == "" {
+			eachField(field.Type, fn)
+			continue
+		}
+		if name == "" || name == "-" {
+			continue
+		}
+		fn(name, field.Type)
+	}
+}
+
+// decodeInput mengubah argumen input GraphQL menjadi model lewat tag json
+func decodeInput(arg interface{}, model interface{}) error {
+	b, err := json.Marshal(arg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, model)
+}
+
+// filterName mengubah nama facet menjadi nama field GraphQL yang valid, misal rank.ninjaRank → rankNinjaRank
+func filterName(facet string) string {
+	parts := strings.Split(facet, ".")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
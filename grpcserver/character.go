@@ -0,0 +1,112 @@
+package grpcserver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gosimple/slug"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"my-gin-app/character"
+	narutov1 "my-gin-app/gen/naruto/v1"
+	"my-gin-app/models"
+	"my-gin-app/search"
+)
+
+type characterServer struct {
+	narutov1.UnimplementedCharacterServiceServer
+	service character.Service
+}
+
+func (s *characterServer) GetCharacter(ctx context.Context, req *narutov1.GetCharacterRequest) (*narutov1.GetCharacterResponse, error) {
+	c, err := s.service.GetCharacterBySlug(ctx, req.GetSlug())
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.GetCharacterResponse{Character: toCharacterPB(*c)}, nil
+}
+
+func (s *characterServer) ListCharacters(req *narutov1.ListCharactersRequest, stream grpc.ServerStreamingServer[narutov1.ListCharactersResponse]) error {
+	ctx := stream.Context()
+	characters, count, err := s.service.ListCharacters(ctx, int(req.GetPage()), int(req.GetLimit()), req.GetFilters())
+	if err != nil {
+		return statusError(ctx, err)
+	}
+
+	if err := stream.SendHeader(metadata.Pairs("x-total-count", strconv.FormatInt(count, 10))); err != nil {
+		return err
+	}
+	for _, c := range characters {
+		if err := stream.Send(&narutov1.ListCharactersResponse{Character: toCharacterPB(c)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchCharacters memakai full-text search jika q diisi, selain itu pencarian nama fuzzy.
+// Tanpa hasil bukan error: hits kosong, dengan saran ejaan untuk pencarian nama.
+func (s *characterServer) SearchCharacters(ctx context.Context, req *narutov1.SearchCharactersRequest) (*narutov1.SearchCharactersResponse, error) {
+	var hits []models.CharacterHit
+	if req.GetQ() != "" {
+		var err error
+		hits, err = s.service.TextSearchCharacters(ctx, req.GetQ(), int(req.GetLimit()))
+		if err != nil && err.Error() != "no characters found" {
+			return nil, statusError(ctx, err)
+		}
+	} else {
+		characters, err := s.service.SearchCharacters(ctx, req.GetName())
+		if err != nil {
+			if err.Error() == "no characters found" {
+				return &narutov1.SearchCharactersResponse{DidYouMean: didYouMean(err)}, nil
+			}
+			return nil, statusError(ctx, err)
+		}
+		for _, c := range characters {
+			hits = append(hits, models.CharacterHit{Character: c, Score: search.NameScore(req.GetName(), c.Name)})
+		}
+	}
+
+	resp := &narutov1.SearchCharactersResponse{}
+	for _, h := range hits {
+		resp.Hits = append(resp.Hits, &narutov1.CharacterHit{
+			Character:  toCharacterPB(h.Character),
+			Score:      h.Score,
+			Highlights: toHighlightsPB(h.Highlights),
+		})
+	}
+	return resp, nil
+}
+
+func (s *characterServer) CreateCharacter(ctx context.Context, req *narutov1.CreateCharacterRequest) (*narutov1.CreateCharacterResponse, error) {
+	c := fromCharacterPB(req.GetCharacter())
+	if err := s.service.CreateCharacter(ctx, &c); err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.CreateCharacterResponse{Character: toCharacterPB(c)}, nil
+}
+
+func (s *characterServer) UpdateCharacter(ctx context.Context, req *narutov1.UpdateCharacterRequest) (*narutov1.UpdateCharacterResponse, error) {
+	c := fromCharacterPB(req.GetCharacter())
+	if err := s.service.UpdateCharacter(ctx, req.GetSlug(), &c); err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	newSlug := req.GetSlug()
+	if c.Name != "" {
+		newSlug = slug.Make(c.Name)
+	}
+	updated, err := s.service.GetCharacterBySlug(ctx, newSlug)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.UpdateCharacterResponse{Character: toCharacterPB(*updated)}, nil
+}
+
+func (s *characterServer) DeleteCharacter(ctx context.Context, req *narutov1.DeleteCharacterRequest) (*narutov1.DeleteCharacterResponse, error) {
+	if err := s.service.DeleteCharacter(ctx, req.GetSlug()); err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.DeleteCharacterResponse{}, nil
+}
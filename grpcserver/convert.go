@@ -0,0 +1,81 @@
+package grpcserver
+
+import (
+	narutov1 "my-gin-app/gen/naruto/v1"
+	"my-gin-app/models"
+)
+
+func toCharacterPB(c models.Character) *narutov1.Character {
+	return &narutov1.Character{
+		Name:   c.Name,
+		Slug:   c.Slug,
+		Images: c.Images,
+		Personal: &narutov1.Personal{
+			Birthdate:   c.Personal.Birthdate,
+			Sex:         c.Personal.Sex,
+			Status:      c.Personal.Status,
+			Height:      c.Personal.Height,
+			Weight:      c.Personal.Weight,
+			BloodType:   c.Personal.BloodType,
+			Occupation:  c.Personal.Occupation,
+			Affiliation: c.Personal.Affiliation,
+			Clan:        c.Personal.Clan,
+		},
+		Rank:  &narutov1.Rank{NinjaRank: c.Rank.NinjaRank},
+		Debut: &narutov1.Debut{Anime: c.Debut.Anime, AppearsIn: c.Debut.AppearsIn},
+		Jutsu: c.Jutsu,
+	}
+}
+
+func fromCharacterPB(c *narutov1.Character) models.Character {
+	p := c.GetPersonal()
+	return models.Character{
+		Name:   c.GetName(),
+		Images: c.GetImages(),
+		Personal: models.Personal{
+			Birthdate:   p.GetBirthdate(),
+			Sex:         p.GetSex(),
+			Status:      p.GetStatus(),
+			Height:      p.GetHeight(),
+			Weight:      p.GetWeight(),
+			BloodType:   p.GetBloodType(),
+			Occupation:  p.GetOccupation(),
+			Affiliation: p.GetAffiliation(),
+			Clan:        p.GetClan(),
+		},
+		Rank:  models.Rank{NinjaRank: c.GetRank().GetNinjaRank()},
+		Debut: models.Debut{Anime: c.GetDebut().GetAnime(), AppearsIn: c.GetDebut().GetAppearsIn()},
+		Jutsu: c.GetJutsu(),
+	}
+}
+
+func toTailedBeastPB(b models.TailedBeast) *narutov1.TailedBeast {
+	return &narutov1.TailedBeast{
+		Name:        b.Name,
+		Slug:        b.Slug,
+		Images:      b.Images,
+		Rank:        b.Rank,
+		Abilities:   b.Abilities,
+		Personality: b.Personality,
+		Jinchuriki:  b.Jinchuriki,
+	}
+}
+
+func fromTailedBeastPB(b *narutov1.TailedBeast) models.TailedBeast {
+	return models.TailedBeast{
+		Name:        b.GetName(),
+		Images:      b.GetImages(),
+		Rank:        b.GetRank(),
+		Abilities:   b.GetAbilities(),
+		Personality: b.GetPersonality(),
+		Jinchuriki:  b.GetJinchuriki(),
+	}
+}
+
+func toHighlightsPB(highlights []models.Highlight) []*narutov1.Highlight {
+	out := make([]*narutov1.Highlight, len(highlights))
+	for i, h := range highlights {
+		out[i] = &narutov1.Highlight{Field: h.Field, Snippet: h.Snippet}
+	}
+	return out
+}
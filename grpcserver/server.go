@@ -0,0 +1,54 @@
+// Package grpcserver menyajikan API gRPC (proto/naruto/v1) di atas service yang sama dengan handler Gin.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"my-gin-app/character"
+	narutov1 "my-gin-app/gen/naruto/v1"
+	"my-gin-app/search"
+	"my-gin-app/tailedbeast"
+)
+
+// NewServer mendaftarkan CharacterService dan TailedBeastService beserta reflection untuk grpcurl
+func NewServer(characters character.Service, tailedBeasts tailedbeast.Service, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	narutov1.RegisterCharacterServiceServer(server, &characterServer{service: characters})
+	narutov1.RegisterTailedBeastServiceServer(server, &tailedBeastServer{service: tailedBeasts})
+	reflection.Register(server)
+	return server
+}
+
+// statusError menerjemahkan error service (dibandingkan lewat pesannya, sama seperti handler REST) menjadi status gRPC.
+// Jika deadline habis atau client membatalkan, status mengikuti error context-nya.
+func statusError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	msg := err.Error()
+	switch {
+	case strings.HasSuffix(msg, "not found"):
+		return status.Error(codes.NotFound, msg)
+	case strings.HasSuffix(msg, "already exists"):
+		return status.Error(codes.AlreadyExists, msg)
+	case strings.HasPrefix(msg, "invalid "), strings.HasSuffix(msg, "is required"):
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return status.Error(codes.Internal, msg)
+}
+
+// didYouMean mengambil saran ejaan dari hasil pencarian nama yang kosong
+func didYouMean(err error) string {
+	var noMatch *search.NoMatchError
+	if errors.As(err, &noMatch) {
+		return noMatch.DidYouMean
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package grpcserver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gosimple/slug"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	narutov1 "my-gin-app/gen/naruto/v1"
+	"my-gin-app/models"
+	"my-gin-app/search"
+	"my-gin-app/tailedbeast"
+)
+
+type tailedBeastServer struct {
+	narutov1.UnimplementedTailedBeastServiceServer
+	service tailedbeast.Service
+}
+
+func (s *tailedBeastServer) GetTailedBeast(ctx context.Context, req *narutov1.GetTailedBeastRequest) (*narutov1.GetTailedBeastResponse, error) {
+	c, err := s.service.GetBeastBySlug(ctx, req.GetSlug())
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.GetTailedBeastResponse{TailedBeast: toTailedBeastPB(*c)}, nil
+}
+
+func (s *tailedBeastServer) ListTailedBeasts(req *narutov1.ListTailedBeastsRequest, stream grpc.ServerStreamingServer[narutov1.ListTailedBeastsResponse]) error {
+	ctx := stream.Context()
+	beasts, count, err := s.service.ListBeasts(ctx, int(req.GetPage()), int(req.GetLimit()), req.GetFilters())
+	if err != nil {
+		return statusError(ctx, err)
+	}
+
+	if err := stream.SendHeader(metadata.Pairs("x-total-count", strconv.FormatInt(count, 10))); err != nil {
+		return err
+	}
+	for _, b := range beasts {
+		if err := stream.Send(&narutov1.ListTailedBeastsResponse{TailedBeast: toTailedBeastPB(b)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchTailedBeasts memakai full-text search jika q diisi, selain itu pencarian nama fuzzy.
+// Tanpa hasil bukan error: hits kosong, dengan saran ejaan untuk pencarian nama.
+func (s *tailedBeastServer) SearchTailedBeasts(ctx context.Context, req *narutov1.SearchTailedBeastsRequest) (*narutov1.SearchTailedBeastsResponse, error) {
+	var hits []models.TailedBeastHit
+	if req.GetQ() != "" {
+		var err error
+		hits, err = s.service.TextSearchBeasts(ctx, req.GetQ(), int(req.GetLimit()))
+		if err != nil && err.Error() != "no tailed beasts found" {
+			return nil, statusError(ctx, err)
+		}
+	} else {
+		beasts, err := s.service.SearchBeasts(ctx, req.GetName())
+		if err != nil {
+			if err.Error() == "no tailed beasts found" {
+				return &narutov1.SearchTailedBeastsResponse{DidYouMean: didYouMean(err)}, nil
+			}
+			return nil, statusError(ctx, err)
+		}
+		for _, b := range beasts {
+			hits = append(hits, models.TailedBeastHit{TailedBeast: b, Score: search.NameScore(req.GetName(), b.Name)})
+		}
+	}
+
+	resp := &narutov1.SearchTailedBeastsResponse{}
+	for _, h := range hits {
+		resp.Hits = append(resp.Hits, &narutov1.TailedBeastHit{
+			TailedBeast: toTailedBeastPB(h.TailedBeast),
+			Score:       h.Score,
+			Highlights:  toHighlightsPB(h.Highlights),
+		})
+	}
+	return resp, nil
+}
+
+func (s *tailedBeastServer) CreateTailedBeast(ctx context.Context, req *narutov1.CreateTailedBeastRequest) (*narutov1.CreateTailedBeastResponse, error) {
+	b := fromTailedBeastPB(req.GetTailedBeast())
+	if err := s.service.CreateBeast(ctx, &b); err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.CreateTailedBeastResponse{TailedBeast: toTailedBeastPB(b)}, nil
+}
+
+func (s *tailedBeastServer) UpdateTailedBeast(ctx context.Context, req *narutov1.UpdateTailedBeastRequest) (*narutov1.UpdateTailedBeastResponse, error) {
+	b := fromTailedBeastPB(req.GetTailedBeast())
+	if err := s.service.UpdateBeast(ctx, req.GetSlug(), &b); err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	newSlug := req.GetSlug()
+	if b.Name != "" {
+		newSlug = slug.Make(b.Name)
+	}
+	updated, err := s.service.GetBeastBySlug(ctx, newSlug)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.UpdateTailedBeastResponse{TailedBeast: toTailedBeastPB(*updated)}, nil
+}
+
+func (s *tailedBeastServer) DeleteTailedBeast(ctx context.Context, req *narutov1.DeleteTailedBeastRequest) (*narutov1.DeleteTailedBeastResponse, error) {
+	if err := s.service.DeleteBeast(ctx, req.GetSlug()); err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &narutov1.DeleteTailedBeastResponse{}, nil
+}
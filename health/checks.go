@@ -0,0 +1,73 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+
+	"my-gin-app/database"
+	"my-gin-app/migrations"
+)
+
+// Check adalah satu dependency yang diperiksa /readyz; Run mengembalikan error jika dependency belum siap
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Mongo memastikan primary MongoDB bisa di-ping
+func Mongo(client *mongo.Client) Check {
+	return Check{
+		Name: "mongo",
+		Run: func(ctx context.Context) error {
+			return client.Ping(ctx, readpref.Primary())
+		},
+	}
+}
+
+// Migrations memastikan tidak ada migration yang belum diterapkan
+func Migrations(migrator *migrations.Migrator) Check {
+	return Check{
+		Name: "migrations",
+		Run: func(ctx context.Context) error {
+			pending, err := migrator.Pending(ctx)
+			if err != nil {
+				return err
+			}
+			if len(pending) > 0 {
+				versions := make([]string, len(pending))
+				for i, m := range pending {
+					versions[i] = fmt.Sprint(m.Version)
+				}
+				return fmt.Errorf("pending migrations: %s", strings.Join(versions, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// Indexes memastikan semua index yang dideklarasikan resource ada; index yang drift tetap dianggap siap
+func Indexes(resource string, status func(ctx context.Context) ([]database.IndexStatus, error)) Check {
+	return Check{
+		Name: "indexes." + resource,
+		Run: func(ctx context.Context) error {
+			statuses, err := status(ctx)
+			if err != nil {
+				return err
+			}
+			var missing []string
+			for _, s := range statuses {
+				if s.State == database.IndexMissing || s.State == database.IndexError {
+					missing = append(missing, s.Name)
+				}
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("missing indexes: %s", strings.Join(missing, ", "))
+			}
+			return nil
+		},
+	}
+}
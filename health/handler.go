@@ -0,0 +1,106 @@
+// Package health menyajikan /healthz (proses hidup) dan /readyz (dependency siap melayani request)
+// untuk healthcheck Docker dan orchestrator.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/response"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Result adalah hasil satu Check
+type Result struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report adalah isi respons /healthz dan /readyz; Checks hanya ada di /readyz
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks,omitempty"`
+}
+
+type Handler struct {
+	Checks  []Check
+	Timeout time.Duration
+}
+
+// NewHandler membuat handler health; timeout membatasi setiap Check
+func NewHandler(timeout time.Duration, checks ...Check) *Handler {
+	return &Handler{
+		Checks:  checks,
+		Timeout: timeout,
+	}
+}
+
+// Live handler untuk /healthz; tidak memeriksa dependency supaya MongoDB yang down tidak membuat proses di-restart
+func (h *Handler) Live(c *gin.Context) {
+	response.OK(c, http.StatusOK, Report{Status: StatusUp}, &response.Meta{Message: "Alive"})
+}
+
+// Ready handler untuk /readyz; semua Check dijalankan paralel, 503 jika ada yang gagal
+func (h *Handler) Ready(c *gin.Context) {
+	report := Report{Status: StatusUp, Checks: make(map[string]Result, len(h.Checks))}
+	var errs []response.Error
+	for name, result := range h.run(c.Request.Context()) {
+		report.Checks[name] = result
+		if result.Status == StatusDown {
+			report.Status = StatusDown
+			e := response.NewError(http.StatusServiceUnavailable, result.Error)
+			e.Source = name
+			errs = append(errs, e)
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool { return errs[i].Source < errs[j].Source })
+		response.Write(c, http.StatusServiceUnavailable, response.Envelope{
+			Data:   report,
+			Meta:   &response.Meta{Message: "Not ready", Healthy: response.Bool(false)},
+			Errors: errs,
+		})
+		return
+	}
+	response.OK(c, http.StatusOK, report, &response.Meta{Message: "Ready", Healthy: response.Bool(true)})
+}
+
+func (h *Handler) run(ctx context.Context) map[string]Result {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]Result, len(h.Checks))
+	for _, check := range h.Checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, h.Timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Run(checkCtx)
+			result := Result{Status: StatusUp, LatencyMs: float64(time.Since(start).Microseconds()) / 1000}
+			if err != nil {
+				result.Status = StatusDown
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			results[check.Name] = result
+			mu.Unlock()
+		}(check)
+	}
+	wg.Wait()
+	return results
+}
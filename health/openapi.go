@@ -0,0 +1,23 @@
+package health
+
+import (
+	"net/http"
+
+	"my-gin-app/openapi"
+)
+
+// Operations mendokumentasikan route health untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/healthz", Tag: "health",
+			Summary: "Liveness: the process is up",
+			Result:  Report{},
+		},
+		{
+			Method: http.MethodGet, Path: "/readyz", Tag: "health",
+			Summary: "Readiness: MongoDB, migrations and indexes per dependency with latency; 503 when not ready",
+			Result:  Report{},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// Package lifecycle menjalankan proses latar (server HTTP, gRPC, worker) dan menghentikannya dengan rapi
+// saat SIGTERM/SIGINT: proses latar dibatalkan, lalu stop hook dijalankan berurutan dalam batas waktu drain.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// hook adalah satu langkah penghentian, misal menutup server atau koneksi database
+type hook struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Lifecycle mengumpulkan proses latar dan stop hook aplikasi
+type Lifecycle struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	hooks  []hook
+	failed chan error
+}
+
+func New() *Lifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Lifecycle{
+		ctx:    ctx,
+		cancel: cancel,
+		failed: make(chan error, 1),
+	}
+}
+
+// OnStop mendaftarkan stop hook. Hook dijalankan terbalik dari urutan pendaftaran (seperti defer),
+// jadi daftarkan dependensi (misal client MongoDB) sebelum yang memakainya (misal server HTTP).
+func (l *Lifecycle) OnStop(name string, stop func(ctx context.Context) error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, hook{name: name, stop: stop})
+}
+
+// Go menjalankan proses latar. ctx dibatalkan saat shutdown dimulai; shutdown menunggu run selesai
+// di posisi pendaftarannya, sehingga hook yang didaftarkan sebelumnya baru jalan setelah run berhenti.
+// Error dari run (selain context.Canceled) memicu shutdown seluruh aplikasi.
+func (l *Lifecycle) Go(name string, run func(ctx context.Context) error) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := run(l.ctx); err != nil && !errors.Is(err, context.Canceled) {
+			select {
+			case l.failed <- fmt.Errorf("%s: %w", name, err):
+			default:
+			}
+		}
+	}()
+
+	l.OnStop(name, func(ctx context.Context) error {
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("still running: %w", ctx.Err())
+		}
+	})
+}
+
+// Run menunggu SIGTERM/SIGINT atau proses latar yang gagal, lalu menjalankan Shutdown dengan batas waktu timeout.
+// Sinyal kedua selama drain menghentikan proses seketika.
+func (l *Lifecycle) Run(timeout time.Duration) error {
+	sigCtx, stopSignals := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+
+	var cause error
+	select {
+	case <-sigCtx.Done():
+		log.Printf("shutdown signal received, draining for up to %s", timeout)
+	case cause = <-l.failed:
+		log.Printf("shutting down: %v", cause)
+	}
+	stopSignals()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return errors.Join(cause, l.Shutdown(ctx))
+}
+
+// Shutdown membatalkan ctx proses latar lalu menjalankan stop hook satu per satu dari yang terakhir didaftarkan.
+// Error setiap hook dikumpulkan; hook berikutnya tetap dijalankan.
+func (l *Lifecycle) Shutdown(ctx context.Context) error {
+	l.cancel()
+
+	l.mu.Lock()
+	hooks := l.hooks
+	l.hooks = nil
+	l.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := hooks[i].stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", hooks[i].name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,65 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor menulis satu baris log per RPC beserta status dan error-nya
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRPCLogger(ctx, logger)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logRPC(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor adalah pasangan UnaryServerInterceptor untuk RPC streaming
+func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRPCLogger(ss.Context(), logger)
+		start := time.Now()
+		err := handler(srv, ss)
+		logRPC(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// withRPCLogger memasang logger dengan request_id dari metadata x-request-id, jika dikirim client
+func withRPCLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get("x-request-id"); len(ids) > 0 && validRequestID.MatchString(ids[0]) {
+			logger = logger.With("request_id", ids[0])
+		}
+	}
+	return WithLogger(ctx, logger)
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("latency", time.Since(start)),
+	}
+
+	level := slog.LevelInfo
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		switch code {
+		case codes.NotFound, codes.AlreadyExists, codes.InvalidArgument, codes.Canceled:
+			level = slog.LevelWarn
+		default:
+			level = slog.LevelError
+		}
+	}
+	FromContext(ctx).LogAttrs(ctx, level, "rpc", attrs...)
+}
@@ -0,0 +1,149 @@
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+
+	"my-gin-app/response"
+)
+
+// RequestIDHeader diterima dari client atau proxy dan selalu dikirim balik di respons
+const RequestIDHeader = "X-Request-ID"
+
+const requestIDKey = "logging.requestID"
+
+// request ID dari luar hanya dipakai jika aman ditulis ke log
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// redactedHeaders tidak pernah ditulis ke log apa adanya
+var redactedHeaders = map[string]bool{
+	"X-Api-Key":           true,
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+}
+
+// RequestID memakai X-Request-ID dari request atau membuat yang baru, menuliskannya di respons, lalu
+// memasang logger request (dengan request_id dan trace_id) di context. Pasang setelah middleware tracing.
+func RequestID(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = newRequestID()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(RequestIDHeader, id)
+
+		requestLogger := logger.With("request_id", id)
+		if span := trace.SpanContextFromContext(c.Request.Context()); span.HasTraceID() {
+			requestLogger = requestLogger.With("trace_id", span.TraceID().String())
+		}
+		c.Request = c.Request.WithContext(WithLogger(c.Request.Context(), requestLogger))
+		c.Next()
+	}
+}
+
+// GetRequestID mengembalikan request ID yang dipasang RequestID
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+// AccessLog menulis satu baris per request. Error yang ditulis handler lewat package response ikut dicatat
+// beserta resource dan slug; 5xx ditulis di level error, 4xx di level warn. Header hanya ditulis di level debug.
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		status := c.Writer.Status()
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", route),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("client_ip", c.ClientIP()),
+			slog.Int("bytes", c.Writer.Size()),
+		}
+		if resource := resourceOf(route); resource != "" {
+			attrs = append(attrs, slog.String("resource", resource))
+		}
+		if slug := c.Param("slug"); slug != "" {
+			attrs = append(attrs, slog.String("slug", slug))
+		}
+		if errs := c.Errors.ByType(gin.ErrorTypeAny); len(errs) > 0 {
+			attrs = append(attrs, slog.Any("errors", errs.Errors()))
+		}
+
+		logger := FromContext(c.Request.Context())
+		ctx := c.Request.Context()
+		if logger.Enabled(ctx, slog.LevelDebug) {
+			attrs = append(attrs, slog.Any("headers", redact(c.Request.Header)))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		logger.LogAttrs(ctx, level, "request", attrs...)
+	}
+}
+
+// Recovery menangkap panic handler, mencatatnya beserta stack trace lalu membalas 500
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				FromContext(c.Request.Context()).Error("panic recovered",
+					"panic", r,
+					"stack", string(debug.Stack()),
+				)
+				if !c.Writer.Written() {
+					response.Fail(c, http.StatusInternalServerError, "Internal server error")
+				}
+				c.Abort()
+			}
+		}()
+		c.Next()
+	}
+}
+
+// resourceOf mengambil resource dari pola route, misal /v1/character/:slug → character
+func resourceOf(route string) string {
+	segments := strings.Split(strings.Trim(route, "/"), "/")
+	if len(segments) > 1 && len(segments[0]) > 1 && segments[0][0] == 'v' && strings.Trim(segments[0][1:], "0123456789") == "" {
+		segments = segments[1:]
+	}
+	return segments[0]
+}
+
+func redact(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		if redactedHeaders[http.CanonicalHeaderKey(name)] {
+			out[name] = "[REDACTED]"
+			continue
+		}
+		out[name] = strings.Join(values, ", ")
+	}
+	return out
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
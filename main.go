@@ -2,61 +2,267 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"log"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
 
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
-	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v3"
 
+	"my-gin-app/admin"
 	"my-gin-app/character"
+	"my-gin-app/config"
+	"my-gin-app/database"
+	"my-gin-app/gql"
+	"my-gin-app/grpcserver"
+	"my-gin-app/health"
+	"my-gin-app/lifecycle"
+	"my-gin-app/logging"
+	"my-gin-app/metrics"
+	"my-gin-app/middleware"
+	"my-gin-app/migrations"
+	"my-gin-app/ratelimit"
+	"my-gin-app/response"
+	"my-gin-app/search"
+	"my-gin-app/stats"
+	"my-gin-app/suggest"
 	"my-gin-app/tailedbeast"
+	"my-gin-app/tracing"
 )
 
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Fatal("Error loading .env file")
+	configFile := flag.String("config", "", "optional YAML or TOML config file, overridden by .env and environment variables (default: CONFIG_FILE)")
+	envFile := flag.String("env", ".env", "optional .env file, overridden by environment variables")
+	printConfig := flag.Bool("print-config", false, "print the effective config (secrets redacted) and exit")
+	migrate := flag.Bool("migrate", false, "apply pending schema migrations before serving (MIGRATE_ON_STARTUP)")
+	legacyResponses := flag.Bool("legacy-responses", false, "write pre-envelope response shapes on every route (LEGACY_RESPONSES)")
+	flag.Parse()
+
+	cfg, err := config.Load(config.Sources{File: *configFile, EnvFile: *envFile})
+	if err != nil {
+		log.Fatalf("invalid config:\n%v", err)
+	}
+	// flag yang diberikan eksplisit menimpa file dan env
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "migrate":
+			cfg.MigrateOnStartup = *migrate
+		case "legacy-responses":
+			cfg.LegacyResponses = *legacyResponses
+		}
+	})
+
+	if *printConfig {
+		out, err := yaml.Marshal(cfg.Redacted())
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(out)
+		return
 	}
 
-	clientOptions := options.Client().ApplyURI(os.Getenv("MONGO_URI"))
-	client, err := mongo.Connect(context.Background(), clientOptions)
+	response.LegacyShapes = cfg.LegacyResponses
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
 	if err != nil {
 		log.Fatal(err)
 	}
+	// log.Printf di package lain ikut ditulis lewat logger ini
+	slog.SetDefault(logger)
+	logger.Info("effective config", "config", cfg.Redacted())
+
+	app := lifecycle.New()
 
-	if err := client.Ping(context.Background(), nil); err != nil {
+	ctx := context.Background()
+	shutdownTracing, err := tracing.Setup(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// span yang tersisa dikirim paling akhir, setelah semua request selesai
+	app.OnStop("tracing", shutdownTracing)
+
+	client, err := database.Connect(ctx, cfg.Mongo, options.Client().
+		SetPoolMonitor(metrics.PoolMonitor()).
+		SetMonitor(otelmongo.NewMonitor()))
+	if err != nil {
 		log.Fatal(err)
 	}
+	// didaftarkan sebelum server supaya client baru ditutup setelah semua server selesai drain
+	app.OnStop("mongo", client.Disconnect)
 
-	db := client.Database(os.Getenv("MONGO_DB"))
+	db := database.Database(client, cfg.Mongo)
 
-	characterRepo := character.NewRepository(db.Collection(os.Getenv("MONGO_COLLECTION")))
-	tailedBeastRepo := tailedbeast.NewRepository(db.Collection(os.Getenv("MONGO_COLLECTION_TAILEDBEAST")))
+	migrator := migrations.NewMigrator(migrations.Target{
+		DB:           db,
+		Characters:   database.CharacterCollection(db, cfg.Mongo),
+		TailedBeasts: database.TailedBeastCollection(db, cfg.Mongo),
+	})
+	if cfg.MigrateOnStartup {
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, m := range applied {
+			log.Printf("migration %d applied: %s", m.Version, m.Description)
+		}
+	}
+
+	characterCollection := database.CharacterCollection(db, cfg.Mongo)
+	tailedBeastCollection := database.TailedBeastCollection(db, cfg.Mongo)
+	characterRepo := metrics.CharacterRepository(tracing.CharacterRepository(character.NewRepository(characterCollection)), characterCollection.Name())
+	tailedBeastRepo := metrics.BeastRepository(tracing.BeastRepository(tailedbeast.NewRepository(tailedBeastCollection)), tailedBeastCollection.Name())
+
+	ensureIndexes(ctx, "character", characterRepo.EnsureIndexes)
+	ensureIndexes(ctx, "tailedbeast", tailedBeastRepo.EnsureIndexes)
+
+	suggestIndex := suggest.NewIndex()
+	characterService := tracing.CharacterService(suggest.CharacterService(character.NewService(characterRepo), suggestIndex))
+	tailedBeastService := tracing.BeastService(suggest.BeastService(tailedbeast.NewService(tailedBeastRepo), suggestIndex))
+
+	if err := suggest.LoadCharacters(ctx, suggestIndex, characterService); err != nil {
+		log.Fatal(err)
+	}
+	if err := suggest.LoadBeasts(ctx, suggestIndex, tailedBeastService); err != nil {
+		log.Fatal(err)
+	}
+
+	searchEngine := search.NewEngine(
+		character.NewSearchResource(characterService),
+		tailedbeast.NewSearchResource(tailedBeastService),
+	)
+	graphqlHandler, err := gql.NewHandler(characterService, tailedBeastService, searchEngine)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	characterService := character.NewService(characterRepo)
-	tailedBeastService := tailedbeast.NewService(tailedBeastRepo)
+	statsHandler := stats.NewHandler(characterService, tailedBeastService, cfg.StatsCacheTTL)
+	for name, c := range statsHandler.Caches() {
+		metrics.RegisterCache(name, c)
+	}
 
-	characterHandler := character.NewHandler(characterService)
-	tailedBeastHandler := tailedbeast.NewHandler(tailedBeastService)
+	// sudah divalidasi oleh config.Load
+	routeOpts := routeOptions{}
+	routeOpts.timeouts, _ = cfg.RouteTimeouts()
+	if cfg.RateLimit.Enabled {
+		quotas, _ := cfg.RateLimit.Quotas()
+		var apiKeys []string
+		if cfg.APIKey != "" {
+			apiKeys = append(apiKeys, cfg.APIKey)
+		}
+		routeOpts.limiter = ratelimit.NewLimiter(ratelimit.NewMemoryStore(), quotas, apiKeys...)
+	}
+	if cfg.CORS.Enabled() {
+		routeOpts.cors = middleware.CORS(cfg.CORS.Policy())
+	}
 
-	router := gin.Default()
+	router := gin.New()
+	if err := router.SetTrustedProxies(cfg.HTTP.Proxies()); err != nil {
+		log.Fatal(err)
+	}
+	if err := response.SetTrustedProxies(cfg.HTTP.Proxies()); err != nil {
+		log.Fatal(err)
+	}
+	router.Use(
+		otelgin.Middleware(tracing.ServiceName),
+		logging.RequestID(logger),
+		logging.AccessLog(),
+		metrics.HTTP(),
+		logging.Recovery(),
+	)
+	registerRoutes(router, handlers{
+		character:   character.NewHandler(characterService),
+		tailedBeast: tailedbeast.NewHandler(tailedBeastService),
+		stats:       statsHandler,
+		search:      search.NewHandler(searchEngine),
+		suggest:     suggest.NewHandler(suggestIndex),
+		admin: admin.NewHandler(map[string]admin.IndexReporter{
+			"character":   characterRepo,
+			"tailedbeast": tailedBeastRepo,
+		}, cfg.APIKey),
+		graphql: graphqlHandler,
+		health: health.NewHandler(cfg.Timeouts.HealthCheck,
+			health.Mongo(client),
+			health.Migrations(migrator),
+			health.Indexes("character", characterRepo.IndexStatus),
+			health.Indexes("tailedbeast", tailedBeastRepo.IndexStatus),
+		),
+	}, routeOpts)
 
-	router.GET("/character", characterHandler.IndexUser)
-	router.GET("/character/search", characterHandler.SearchCharacter)
-	router.POST("/character", characterHandler.CreateUser)
-	router.GET("/character/:slug", characterHandler.ReadUser)
-	router.PUT("/character/:slug", characterHandler.UpdateUser)
-	router.DELETE("/character/:slug", characterHandler.DeleteUser)
+	httpServer := &http.Server{Addr: cfg.HTTP.Addr(), Handler: router}
+	app.Go("http", func(ctx context.Context) error {
+		log.Printf("HTTP server listening on %s", httpServer.Addr)
+		if err := httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	app.OnStop("http", httpServer.Shutdown)
 
-	router.GET("/tailedbeast", tailedBeastHandler.IndexTailedBeast)
-	router.GET("/tailedbeast/search", tailedBeastHandler.SearchTailedBeast)
-	router.POST("/tailedbeast", tailedBeastHandler.CreateTailedBeast)
-	router.GET("/tailedbeast/:slug", tailedBeastHandler.ReadTailedBeast)
-	router.PUT("/tailedbeast/:slug", tailedBeastHandler.UpdateTailedBeast)
-	router.DELETE("/tailedbeast/:slug", tailedBeastHandler.DeleteTailedBeast)
+	grpcServer := grpcserver.NewServer(characterService, tailedBeastService,
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(logging.UnaryServerInterceptor(logger)),
+		grpc.ChainStreamInterceptor(logging.StreamServerInterceptor(logger)),
+	)
+	grpcListener, err := net.Listen("tcp", cfg.GRPC.Addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	app.Go("grpc", func(ctx context.Context) error {
+		log.Printf("gRPC server listening on %s", grpcListener.Addr())
+		return grpcServer.Serve(grpcListener)
+	})
+	app.OnStop("grpc", stopGRPC(grpcServer))
 
-	if err := router.Run(":8001"); err != nil {
+	if err := app.Run(cfg.Timeouts.Shutdown); err != nil {
 		log.Fatal(err)
 	}
+	log.Print("shutdown complete")
+}
+
+// stopGRPC menunggu RPC yang sedang berjalan selesai; jika batas waktu drain habis, koneksi diputus paksa
+func stopGRPC(server *grpc.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		done := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// ensureIndexes membuat index yang belum ada dan memberi peringatan untuk index yang berbeda dari definisi
+func ensureIndexes(ctx context.Context, resource string, ensure func(context.Context) ([]database.IndexStatus, error)) {
+	statuses, err := ensure(ctx)
+	if err != nil {
+		log.Printf("%s: index reconciliation failed: %v", resource, err)
+		return
+	}
+
+	for _, s := range statuses {
+		switch s.State {
+		case database.IndexCreated:
+			log.Printf("%s: created index %s", resource, s.Name)
+		case database.IndexDrift, database.IndexError:
+			log.Printf("WARNING %s: index %s is %s: %s", resource, s.Name, s.State, s.Detail)
+		case database.IndexExtra:
+			log.Printf("%s: undeclared index %s on %s", resource, s.Name, s.Collection)
+		}
+	}
 }
@@ -0,0 +1,546 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/admin"
+	"my-gin-app/character"
+	"my-gin-app/database"
+	"my-gin-app/health"
+	"my-gin-app/logging"
+	"my-gin-app/middleware"
+	"my-gin-app/models"
+	"my-gin-app/openapi"
+	"my-gin-app/ratelimit"
+	"my-gin-app/response"
+	"my-gin-app/suggest"
+)
+
+func TestOpenAPIMatchesRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerRoutes(router, handlers{}, routeOptions{})
+
+	for _, problem := range openapi.Diff(router.Routes(), operations()) {
+		t.Error(problem)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /openapi.json returned %d", w.Code)
+	}
+
+	var doc struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI != "3.1.0" {
+		t.Errorf("openapi version = %q, want 3.1.0", doc.OpenAPI)
+	}
+	for _, r := range router.Routes() {
+		path := openapi.OpenAPIPath(r.Path)
+		if _, ok := doc.Paths[path][strings.ToLower(r.Method)]; !ok {
+			t.Errorf("spec is missing %s %s", r.Method, path)
+		}
+	}
+}
+
+func TestDocsServeEmbeddedSwaggerUI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerRoutes(router, handlers{}, routeOptions{})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	if w.Code != http.StatusOK || strings.Contains(w.Body.String(), "unpkg.com") {
+		t.Fatalf("GET /docs returned %d and must not load assets from a CDN", w.Code)
+	}
+
+	for path, contentType := range map[string]string{
+		"/docs/assets/swagger-ui.css":       "text/css",
+		"/docs/assets/swagger-ui-bundle.js": "javascript",
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusOK || w.Body.Len() == 0 || !strings.Contains(w.Header().Get("Content-Type"), contentType) {
+			t.Errorf("GET %s returned %d with Content-Type %q", path, w.Code, w.Header().Get("Content-Type"))
+		}
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/assets/LICENSE", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GET /docs/assets/LICENSE returned %d, want 404", w.Code)
+	}
+}
+
+func TestLegacyAliasesAreDeprecated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerRoutes(router, handlers{suggest: suggest.NewHandler(suggest.NewIndex())}, routeOptions{})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/suggest?q=nar", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /suggest returned %d", w.Code)
+	}
+	if w.Header().Get("Deprecation") == "" || w.Header().Get("Sunset") == "" {
+		t.Errorf("legacy alias is missing Deprecation/Sunset headers: %v", w.Header())
+	}
+	if link := w.Header().Get("Link"); link != `</v1/suggest?q=nar>; rel="successor-version"` {
+		t.Errorf("Link = %q", link)
+	}
+	if body := decode(t, w); body["result"] == nil || body["data"] != nil {
+		t.Errorf("legacy alias must keep the {message, result} shape: %v", body)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/suggest?q=nar", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /v1/suggest returned %d", w.Code)
+	}
+	if w.Header().Get("Deprecation") != "" {
+		t.Errorf("/v1 route must not be deprecated")
+	}
+	if body := decode(t, w); body["data"] == nil || body["result"] != nil {
+		t.Errorf("/v1 route must use the response envelope: %v", body)
+	}
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder) map[string]interface{} {
+	t.Helper()
+	var body map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestLinksHonorProxyHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	index := suggest.NewIndex()
+	index.Put(suggest.Entry{Slug: "naruto-uzumaki", Name: "Naruto Uzumaki", Type: suggest.TypeCharacter})
+	router := gin.New()
+	registerRoutes(router, handlers{suggest: suggest.NewHandler(index)}, routeOptions{})
+
+	// httptest memakai 192.0.2.1 sebagai alamat peer
+	if err := response.SetTrustedProxies([]string{"192.0.2.0/24"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = response.SetTrustedProxies(nil) })
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:    "trusted proxy",
+			headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "api.example.com", "X-Forwarded-Prefix": "/naruto/"},
+			want:    "https://api.example.com/naruto/v1/character/naruto-uzumaki",
+		},
+		{
+			name:    "Forwarded header wins over X-Forwarded-*",
+			headers: map[string]string{"Forwarded": `proto=https;host="edge.example.com"`, "X-Forwarded-Host": "api.example.com"},
+			want:    "https://edge.example.com/v1/character/naruto-uzumaki",
+		},
+		{
+			name:    "scheme other than http/https is ignored",
+			headers: map[string]string{"X-Forwarded-Proto": "javascript", "X-Forwarded-Host": "api.example.com"},
+			want:    "http://api.example.com/v1/character/naruto-uzumaki",
+		},
+		{
+			name:    "untrusted peer",
+			remote:  "203.0.113.7:4000",
+			headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "evil.example.com", "X-Forwarded-Prefix": "/x"},
+			want:    "http://example.com/v1/character/naruto-uzumaki",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/suggest?q=nar", nil)
+			if tt.remote != "" {
+				req.RemoteAddr = tt.remote
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			var body struct {
+				Data []struct {
+					Links map[string]struct {
+						Href string `json:"href"`
+					} `json:"_links"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			if len(body.Data) != 1 {
+				t.Fatalf("expected one suggestion, got %s", w.Body.String())
+			}
+			if href := body.Data[0].Links["self"].Href; href != tt.want {
+				t.Errorf("self href = %q, want %q", href, tt.want)
+			}
+		})
+	}
+}
+
+// brokenIndexes gagal seperti driver MongoDB yang tidak bisa membaca index
+type brokenIndexes struct{}
+
+func (brokenIndexes) IndexStatus(ctx context.Context) ([]database.IndexStatus, error) {
+	return nil, errors.New("connection(db-internal:27017) refused: naruto.characters")
+}
+
+func TestAdminRequiresAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	reporters := map[string]admin.IndexReporter{"character": brokenIndexes{}}
+
+	tests := []struct {
+		name, configured, sent string
+		want                   int
+	}{
+		{"disabled without X_API_KEY", "", "", http.StatusForbidden},
+		{"missing key", "secret", "", http.StatusUnauthorized},
+		{"wrong key", "secret", "guess", http.StatusUnauthorized},
+		{"valid key", "secret", "secret", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			registerRoutes(router, handlers{admin: admin.NewHandler(reporters, tt.configured)}, routeOptions{})
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/admin/indexes", nil)
+			if tt.sent != "" {
+				req.Header.Set(admin.APIKeyHeader, tt.sent)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.want, w.Body.String())
+			}
+			if strings.Contains(w.Body.String(), "db-internal") {
+				t.Fatalf("driver error leaked: %s", w.Body.String())
+			}
+		})
+	}
+}
+
+// slowCharacters menunggu sampai context request selesai, seperti query MongoDB yang lambat
+type slowCharacters struct {
+	character.Service
+}
+
+func (slowCharacters) ListCharacters(ctx context.Context, page, limit int, filters map[string]string, fields ...string) ([]models.Character, int64, error) {
+	<-ctx.Done()
+	return nil, 0, ctx.Err()
+}
+
+// emptyCharacters mensimulasikan collection kosong atau filter yang tidak cocok dengan dokumen mana pun
+type emptyCharacters struct {
+	character.Service
+}
+
+func (emptyCharacters) ListCharacters(ctx context.Context, page, limit int, filters map[string]string, fields ...string) ([]models.Character, int64, error) {
+	return nil, 0, nil
+}
+
+func TestEmptyListKeepsData(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerRoutes(router, handlers{character: character.NewHandler(emptyCharacters{})}, routeOptions{})
+
+	tests := []struct {
+		path, key string
+	}{
+		{"/v1/character", "data"},
+		{"/v1/character?page=1&limit=10", "data"},
+		{"/v1/character?clan=Nobody", "data"},
+		{"/character", "result"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET %s returned %d: %s", tt.path, w.Code, w.Body.String())
+		}
+		var body map[string]json.RawMessage
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		if got := string(body[tt.key]); got != "[]" {
+			t.Errorf("GET %s: %q = %s, want [] in %s", tt.path, tt.key, got, w.Body.String())
+		}
+	}
+}
+
+// oneCharacter mengembalikan satu karakter untuk slug apa pun
+type oneCharacter struct {
+	character.Service
+}
+
+func (oneCharacter) GetCharacterBySlug(ctx context.Context, slug string, fields ...string) (*models.Character, error) {
+	return &models.Character{Name: "Naruto Uzumaki", Slug: slug}, nil
+}
+
+func TestCharacterLinksToTailedBeasts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerRoutes(router, handlers{character: character.NewHandler(oneCharacter{})}, routeOptions{})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/character/naruto-uzumaki", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body.String())
+	}
+	var body struct {
+		Data struct {
+			Links response.Links `json:"_links"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	want := "http://example.com/v1/tailedbeast?jinchuriki=naruto-uzumaki"
+	if got := body.Data.Links["tailedBeasts"].Href; got != want {
+		t.Errorf("tailedBeasts = %q, want %q", got, want)
+	}
+}
+
+func TestRouteDeadline(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	timeouts, err := middleware.ParseTimeouts(time.Minute, "GET /character=10ms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registerRoutes(router, handlers{character: character.NewHandler(slowCharacters{})}, routeOptions{timeouts: timeouts})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/character", nil))
+	if w.Code != http.StatusGatewayTimeout {
+		t.Fatalf("GET /v1/character returned %d, want 504", w.Code)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/character", nil).WithContext(ctx))
+	if w.Code != 499 {
+		t.Fatalf("cancelled GET /character returned %d, want 499", w.Code)
+	}
+	if body := decode(t, w); body["error"] != "Client closed request" {
+		t.Errorf("legacy error = %v", body["error"])
+	}
+}
+
+func TestHealthProbes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerRoutes(router, handlers{health: health.NewHandler(10*time.Millisecond,
+		health.Check{Name: "mongo", Run: func(ctx context.Context) error { return nil }},
+		health.Check{Name: "migrations", Run: func(ctx context.Context) error { <-ctx.Done(); return ctx.Err() }},
+	)}, routeOptions{})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /healthz returned %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("GET /readyz returned %d, want 503", w.Code)
+	}
+	var body struct {
+		Data health.Report `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Data.Checks["mongo"].Status != health.StatusUp {
+		t.Errorf("mongo = %+v, want up", body.Data.Checks["mongo"])
+	}
+	if check := body.Data.Checks["migrations"]; check.Status != health.StatusDown || check.Error == "" {
+		t.Errorf("migrations = %+v, want down with error", check)
+	}
+}
+
+func TestRequestIDAndErrorLogging(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var logs bytes.Buffer
+	logger, err := logging.New(&logs, "debug", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := gin.New()
+	router.Use(logging.RequestID(logger), logging.AccessLog())
+	registerRoutes(router, handlers{character: character.NewHandler(slowCharacters{})}, routeOptions{})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/character/naruto-uzumaki", strings.NewReader("{"))
+	req.Header.Set(logging.RequestIDHeader, "abc-123")
+	req.Header.Set("X-API-Key", "secret-key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if got := w.Header().Get(logging.RequestIDHeader); got != "abc-123" {
+		t.Errorf("X-Request-ID = %q, want abc-123", got)
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(logs.Bytes(), &entry); err != nil {
+		t.Fatalf("access log is not JSON: %v\n%s", err, logs.String())
+	}
+	if entry["request_id"] != "abc-123" || entry["resource"] != "character" || entry["slug"] != "naruto-uzumaki" || entry["level"] != "WARN" {
+		t.Errorf("unexpected access log: %s", logs.String())
+	}
+	if entry["errors"] == nil {
+		t.Errorf("access log is missing the handler error: %s", logs.String())
+	}
+	if strings.Contains(logs.String(), "secret-key") {
+		t.Errorf("access log leaks the API key: %s", logs.String())
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if got := w.Header().Get(logging.RequestIDHeader); len(got) != 32 {
+		t.Errorf("generated X-Request-ID = %q", got)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Quota{
+		ratelimit.Read:   {Limit: 2, Window: time.Minute},
+		ratelimit.Export: {Limit: 1, Window: time.Minute},
+	}, "known-key")
+	registerRoutes(router, handlers{
+		suggest:   suggest.NewHandler(suggest.NewIndex()),
+		character: character.NewHandler(slowCharacters{}),
+		health:    health.NewHandler(time.Second),
+	}, routeOptions{limiter: limiter})
+
+	get := func(path, ip, apiKey string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":1234"
+		if apiKey != "" {
+			req.Header.Set(ratelimit.APIKeyHeader, apiKey)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	for i := 0; i < 2; i++ {
+		if w := get("/v1/suggest?q=nar", "10.0.0.1", ""); w.Code != http.StatusOK || w.Header().Get("RateLimit-Limit") != "2" {
+			t.Fatalf("request %d returned %d with headers %v", i+1, w.Code, w.Header())
+		}
+	}
+	w := get("/v1/suggest?q=nar", "10.0.0.1", "random-key")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "30" || w.Header().Get("RateLimit-Remaining") != "0" {
+		t.Fatalf("third request returned %d with headers %v", w.Code, w.Header())
+	}
+
+	if w := get("/v1/suggest?q=nar", "10.0.0.2", ""); w.Code != http.StatusOK {
+		t.Errorf("other client IP returned %d", w.Code)
+	}
+	if w := get("/v1/suggest?q=nar", "10.0.0.1", "known-key"); w.Code != http.StatusOK {
+		t.Errorf("known API key returned %d", w.Code)
+	}
+	if w := get("/healthz", "10.0.0.1", ""); w.Code != http.StatusOK || w.Header().Get("RateLimit-Limit") != "" {
+		t.Errorf("/healthz is rate limited: %d %v", w.Code, w.Header())
+	}
+
+	// GET /character tanpa halaman memakai kuota export yang terpisah dari read
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/v1/character", nil).WithContext(ctx)
+	req.RemoteAddr = "10.0.0.3:1234"
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Header().Get("RateLimit-Limit") != "1" {
+		t.Errorf("unpaginated list used quota %q, want export", w.Header().Get("RateLimit-Limit"))
+	}
+}
+
+func TestCORS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerRoutes(router, handlers{
+		suggest: suggest.NewHandler(suggest.NewIndex()),
+		health:  health.NewHandler(time.Second),
+	}, routeOptions{cors: middleware.CORS(middleware.CORSPolicy{
+		AllowedOrigins:   []string{"https://naruto.example.org", "https://*.preview.example.org"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut},
+		AllowedHeaders:   []string{"Content-Type", "X-API-Key", "If-Match"},
+		ExposedHeaders:   []string{"X-Request-ID"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	})})
+
+	for _, problem := range openapi.Diff(router.Routes(), operations()) {
+		t.Error(problem)
+	}
+
+	preflight := func(path, origin, method string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, path, nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", method)
+		req.Header.Set("Access-Control-Request-Headers", "x-api-key, if-match")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// setiap route group punya preflight: /v1, alias lama, GraphQL, health dan dokumentasi
+	for _, path := range []string{"/v1/character/naruto", "/character", "/graphql", "/readyz", "/openapi.json"} {
+		w := preflight(path, "https://pr-12.preview.example.org", http.MethodPut)
+		if w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Allow-Origin") != "https://pr-12.preview.example.org" {
+			t.Errorf("preflight %s returned %d with headers %v", path, w.Code, w.Header())
+		}
+	}
+	w := preflight("/v1/suggest", "https://naruto.example.org", http.MethodGet)
+	if w.Header().Get("Access-Control-Allow-Headers") != "Content-Type, X-API-Key, If-Match" ||
+		w.Header().Get("Access-Control-Allow-Credentials") != "true" || w.Header().Get("Access-Control-Max-Age") != "600" {
+		t.Errorf("preflight headers %v", w.Header())
+	}
+	if w := preflight("/v1/suggest", "https://evil.example.com", http.MethodGet); w.Code != http.StatusForbidden {
+		t.Errorf("preflight from unknown origin returned %d", w.Code)
+	}
+	if w := preflight("/v1/suggest", "https://preview.example.org", http.MethodGet); w.Code != http.StatusForbidden {
+		t.Errorf("wildcard matched the bare domain: %d", w.Code)
+	}
+	if w := preflight("/v1/character/naruto", "https://naruto.example.org", http.MethodDelete); w.Code != http.StatusForbidden {
+		t.Errorf("preflight for a disallowed method returned %d", w.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/suggest?q=nar", nil)
+	req.Header.Set("Origin", "https://naruto.example.org")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || w.Header().Get("Access-Control-Allow-Origin") != "https://naruto.example.org" ||
+		w.Header().Get("Access-Control-Expose-Headers") != "X-Request-ID" || w.Header().Get("Vary") != "Origin" {
+		t.Errorf("GET with Origin returned %d with headers %v", w.Code, w.Header())
+	}
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"my-gin-app/cache"
+)
+
+var (
+	cacheHitsDesc   = prometheus.NewDesc("cache_hits_total", "Cache hits per cache.", []string{"cache"}, nil)
+	cacheMissesDesc = prometheus.NewDesc("cache_misses_total", "Cache misses per cache.", []string{"cache"}, nil)
+	cacheRatioDesc  = prometheus.NewDesc("cache_hit_ratio", "Cache hits divided by lookups since start per cache.", []string{"cache"}, nil)
+)
+
+// cacheCollector membaca cache.Stats() saat di-scrape sehingga cache tidak perlu tahu tentang Prometheus
+type cacheCollector struct {
+	name  string
+	cache cache.StatsReporter
+}
+
+// RegisterCache mendaftarkan hit, miss dan hit ratio sebuah cache dengan label cache=name
+func RegisterCache(name string, c cache.StatsReporter) {
+	Registry.MustRegister(&cacheCollector{name: name, cache: c})
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cacheHitsDesc
+	ch <- cacheMissesDesc
+	ch <- cacheRatioDesc
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	hits, misses := c.cache.Stats()
+	ch <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, float64(hits), c.name)
+	ch <- prometheus.MustNewConstMetric(cacheMissesDesc, prometheus.CounterValue, float64(misses), c.name)
+
+	var ratio float64
+	if lookups := hits + misses; lookups > 0 {
+		ratio = float64(hits) / float64(lookups)
+	}
+	ch <- prometheus.MustNewConstMetric(cacheRatioDesc, prometheus.GaugeValue, ratio, c.name)
+}
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"my-gin-app/character"
+	"my-gin-app/database"
+	"my-gin-app/models"
+)
+
+// characterRepository membungkus character.Repository supaya setiap operasi tercatat durasi dan error-nya
+type characterRepository struct {
+	character.Repository
+	collection string
+}
+
+// CharacterRepository mengembalikan character.Repository yang mencatat metrik dengan label collection
+func CharacterRepository(inner character.Repository, collection string) character.Repository {
+	return &characterRepository{
+		Repository: inner,
+		collection: collection,
+	}
+}
+
+func (r *characterRepository) Create(ctx context.Context, character *models.Character) (err error) {
+	defer observe(r.collection, "create", time.Now(), &err)
+	return r.Repository.Create(ctx, character)
+}
+
+func (r *characterRepository) FindBySlug(ctx context.Context, slug string, fields ...string) (result *models.Character, err error) {
+	defer observe(r.collection, "find_by_slug", time.Now(), &err)
+	return r.Repository.FindBySlug(ctx, slug, fields...)
+}
+
+func (r *characterRepository) UpdateBySlug(ctx context.Context, slug string, update bson.M) (err error) {
+	defer observe(r.collection, "update_by_slug", time.Now(), &err)
+	return r.Repository.UpdateBySlug(ctx, slug, update)
+}
+
+func (r *characterRepository) DeleteBySlug(ctx context.Context, slug string) (err error) {
+	defer observe(r.collection, "delete_by_slug", time.Now(), &err)
+	return r.Repository.DeleteBySlug(ctx, slug)
+}
+
+func (r *characterRepository) ListCharacters(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) (result []models.Character, err error) {
+	defer observe(r.collection, "list", time.Now(), &err)
+	return r.Repository.ListCharacters(ctx, filter, skip, limit, fields...)
+}
+
+func (r *characterRepository) CountCharacters(ctx context.Context, filter bson.M) (result int64, err error) {
+	defer observe(r.collection, "count", time.Now(), &err)
+	return r.Repository.CountCharacters(ctx, filter)
+}
+
+func (r *characterRepository) Facets(ctx context.Context, filter bson.M, facets []string) (result models.Facets, err error) {
+	defer observe(r.collection, "facets", time.Now(), &err)
+	return r.Repository.Facets(ctx, filter, facets)
+}
+
+func (r *characterRepository) Stats(ctx context.Context, top int64, groupBy string) (result *models.CharacterStats, err error) {
+	defer observe(r.collection, "stats", time.Now(), &err)
+	return r.Repository.Stats(ctx, top, groupBy)
+}
+
+func (r *characterRepository) TextSearch(ctx context.Context, query string, limit int64, fields ...string) (result []models.CharacterHit, err error) {
+	defer observe(r.collection, "text_search", time.Now(), &err)
+	return r.Repository.TextSearch(ctx, query, limit, fields...)
+}
+
+func (r *characterRepository) EnsureIndexes(ctx context.Context) (result []database.IndexStatus, err error) {
+	defer observe(r.collection, "ensure_indexes", time.Now(), &err)
+	return r.Repository.EnsureIndexes(ctx)
+}
+
+func (r *characterRepository) IndexStatus(ctx context.Context) (result []database.IndexStatus, err error) {
+	defer observe(r.collection, "index_status", time.Now(), &err)
+	return r.Repository.IndexStatus(ctx)
+}
@@ -0,0 +1,17 @@
+package metrics
+
+import (
+	"net/http"
+
+	"my-gin-app/openapi"
+)
+
+// Operations mendokumentasikan route metrik untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/metrics", Tag: "health",
+			Summary: "Prometheus metrics in text exposition format",
+		},
+	}
+}
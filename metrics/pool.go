@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+var (
+	poolOpen = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mongo_pool_open_connections",
+		Help: "Open connections in the MongoDB connection pool per server.",
+	}, []string{"address"})
+
+	poolInUse = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mongo_pool_in_use_connections",
+		Help: "Connections checked out of the MongoDB connection pool per server.",
+	}, []string{"address"})
+
+	poolCheckoutDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mongo_pool_checkout_duration_seconds",
+		Help:    "Time spent waiting for a MongoDB connection per server.",
+		Buckets: []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+	}, []string{"address"})
+
+	poolCheckoutFailures = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "mongo_pool_checkout_failures_total",
+		Help: "Failed MongoDB connection checkouts per server and reason.",
+	}, []string{"address", "reason"})
+)
+
+// PoolMonitor menerjemahkan event pool driver MongoDB menjadi metrik; pasang lewat options.Client().SetPoolMonitor
+func PoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			switch e.Type {
+			case event.ConnectionCreated:
+				poolOpen.WithLabelValues(e.Address).Inc()
+			case event.ConnectionClosed:
+				poolOpen.WithLabelValues(e.Address).Dec()
+			case event.GetSucceeded:
+				poolInUse.WithLabelValues(e.Address).Inc()
+				poolCheckoutDuration.WithLabelValues(e.Address).Observe(e.Duration.Seconds())
+			case event.ConnectionReturned:
+				poolInUse.WithLabelValues(e.Address).Dec()
+			case event.GetFailed:
+				poolCheckoutFailures.WithLabelValues(e.Address, e.Reason).Inc()
+			}
+		},
+	}
+}
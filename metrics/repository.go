@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	repositoryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "repository_operation_duration_seconds",
+		Help:    "Repository operation latency by collection and operation.",
+		Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"collection", "operation"})
+
+	repositoryErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "repository_errors_total",
+		Help: "Repository operation errors by collection, operation and kind (not_found, conflict, timeout, canceled, error).",
+	}, []string{"collection", "operation", "kind"})
+)
+
+// observe dipanggil lewat defer dari decorator repository; err menunjuk named result method
+func observe(collection, operation string, start time.Time, err *error) {
+	repositoryDuration.WithLabelValues(collection, operation).Observe(time.Since(start).Seconds())
+	if *err != nil {
+		repositoryErrors.WithLabelValues(collection, operation, errorKind(*err)).Inc()
+	}
+}
+
+// errorKind mengelompokkan error supaya "not found" yang wajar bisa dipisahkan dari kegagalan MongoDB
+func errorKind(err error) string {
+	msg := err.Error()
+	switch {
+	case strings.HasSuffix(msg, "not found"):
+		return "not_found"
+	case strings.HasSuffix(msg, "already exists"):
+		return "conflict"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	}
+	return "error"
+}
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"my-gin-app/database"
+	"my-gin-app/models"
+	"my-gin-app/tailedbeast"
+)
+
+// beastRepository membungkus tailedbeast.Repository supaya setiap operasi tercatat durasi dan error-nya
+type beastRepository struct {
+	tailedbeast.Repository
+	collection string
+}
+
+// BeastRepository mengembalikan tailedbeast.Repository yang mencatat metrik dengan label collection
+func BeastRepository(inner tailedbeast.Repository, collection string) tailedbeast.Repository {
+	return &beastRepository{
+		Repository: inner,
+		collection: collection,
+	}
+}
+
+func (r *beastRepository) Create(ctx context.Context, beast *models.TailedBeast) (err error) {
+	defer observe(r.collection, "create", time.Now(), &err)
+	return r.Repository.Create(ctx, beast)
+}
+
+func (r *beastRepository) FindBySlug(ctx context.Context, slug string, fields ...string) (result *models.TailedBeast, err error) {
+	defer observe(r.collection, "find_by_slug", time.Now(), &err)
+	return r.Repository.FindBySlug(ctx, slug, fields...)
+}
+
+func (r *beastRepository) UpdateBySlug(ctx context.Context, slug string, update bson.M) (err error) {
+	defer observe(r.collection, "update_by_slug", time.Now(), &err)
+	return r.Repository.UpdateBySlug(ctx, slug, update)
+}
+
+func (r *beastRepository) DeleteBySlug(ctx context.Context, slug string) (err error) {
+	defer observe(r.collection, "delete_by_slug", time.Now(), &err)
+	return r.Repository.DeleteBySlug(ctx, slug)
+}
+
+func (r *beastRepository) ListBeasts(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) (result []models.TailedBeast, err error) {
+	defer observe(r.collection, "list", time.Now(), &err)
+	return r.Repository.ListBeasts(ctx, filter, skip, limit, fields...)
+}
+
+func (r *beastRepository) CountBeasts(ctx context.Context, filter bson.M) (result int64, err error) {
+	defer observe(r.collection, "count", time.Now(), &err)
+	return r.Repository.CountBeasts(ctx, filter)
+}
+
+func (r *beastRepository) Facets(ctx context.Context, filter bson.M, facets []string) (result models.Facets, err error) {
+	defer observe(r.collection, "facets", time.Now(), &err)
+	return r.Repository.Facets(ctx, filter, facets)
+}
+
+func (r *beastRepository) Stats(ctx context.Context, groupBy string) (result *models.TailedBeastStats, err error) {
+	defer observe(r.collection, "stats", time.Now(), &err)
+	return r.Repository.Stats(ctx, groupBy)
+}
+
+func (r *beastRepository) TextSearch(ctx context.Context, query string, limit int64, fields ...string) (result []models.TailedBeastHit, err error) {
+	defer observe(r.collection, "text_search", time.Now(), &err)
+	return r.Repository.TextSearch(ctx, query, limit, fields...)
+}
+
+func (r *beastRepository) EnsureIndexes(ctx context.Context) (result []database.IndexStatus, err error) {
+	defer observe(r.collection, "ensure_indexes", time.Now(), &err)
+	return r.Repository.EnsureIndexes(ctx)
+}
+
+func (r *beastRepository) IndexStatus(ctx context.Context) (result []database.IndexStatus, err error) {
+	defer observe(r.collection, "index_status", time.Now(), &err)
+	return r.Repository.IndexStatus(ctx)
+}
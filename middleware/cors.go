@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CORSPolicy menentukan origin browser yang boleh memanggil API. AllowedOrigins berisi origin persis
+// ("https://app.example.com"), pola dengan satu wildcard ("https://*.example.com") atau "*" untuk semua origin.
+type CORSPolicy struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// Validate menolak pola origin yang tidak bisa cocok dengan header Origin, dan "*" bersama credentials
+// karena itu berarti setiap situs bisa memanggil API atas nama user yang sedang login
+func (p CORSPolicy) Validate() error {
+	var errs []error
+	for _, origin := range p.AllowedOrigins {
+		if origin == "*" {
+			if p.AllowCredentials {
+				errs = append(errs, errors.New(`origin "*" cannot be combined with credentials`))
+			}
+			continue
+		}
+		scheme, host, ok := strings.Cut(origin, "://")
+		if !ok || scheme == "" || host == "" || strings.Contains(host, "/") {
+			errs = append(errs, fmt.Errorf("origin %q must look like scheme://host[:port]", origin))
+			continue
+		}
+		if strings.Count(origin, "*") > 1 || strings.Contains(scheme, "*") {
+			errs = append(errs, fmt.Errorf("origin %q may only use one wildcard in the host", origin))
+		}
+	}
+	if p.MaxAge < 0 {
+		errs = append(errs, errors.New("max age must not be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+// allowOrigin mencocokkan header Origin dengan AllowedOrigins; wildcard hanya mencakup bagian host
+func (p CORSPolicy) allowOrigin(origin string) bool {
+	for _, pattern := range p.AllowedOrigins {
+		if pattern == "*" || strings.EqualFold(pattern, origin) {
+			return true
+		}
+		prefix, suffix, ok := strings.Cut(strings.ToLower(pattern), "*")
+		if !ok {
+			continue
+		}
+		o := strings.ToLower(origin)
+		if len(o) > len(prefix)+len(suffix) && strings.HasPrefix(o, prefix) && strings.HasSuffix(o, suffix) &&
+			!strings.ContainsAny(o[len(prefix):len(o)-len(suffix)], "/:@") {
+			return true
+		}
+	}
+	return false
+}
+
+func (p CORSPolicy) allowMethod(method string) bool {
+	for _, m := range p.AllowedMethods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// CORS menulis header CORS untuk origin yang diizinkan dan menjawab preflight (OPTIONS dengan
+// Access-Control-Request-Method) dengan 204 tanpa menjalankan handler. Preflight dari origin atau
+// untuk method yang tidak diizinkan ditolak dengan 403. Request tanpa Origin diteruskan apa adanya.
+func CORS(p CORSPolicy) gin.HandlerFunc {
+	allowMethods := strings.Join(p.AllowedMethods, ", ")
+	allowHeaders := strings.Join(p.AllowedHeaders, ", ")
+	exposeHeaders := strings.Join(p.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(p.MaxAge.Seconds()))
+	anyOrigin := len(p.AllowedOrigins) == 1 && p.AllowedOrigins[0] == "*"
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+		if !anyOrigin {
+			// respons berbeda per origin, jadi cache di depan API harus membedakannya
+			c.Writer.Header().Add("Vary", "Origin")
+		}
+		if preflight {
+			c.Writer.Header().Add("Vary", "Access-Control-Request-Method")
+			c.Writer.Header().Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		if origin == "" {
+			c.Next()
+			return
+		}
+		if !p.allowOrigin(origin) {
+			if preflight {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if anyOrigin {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Header("Access-Control-Allow-Origin", origin)
+		}
+		if p.AllowCredentials {
+			c.Header("Access-Control-Allow-Credentials", "true")
+		}
+
+		if !preflight {
+			if exposeHeaders != "" {
+				c.Header("Access-Control-Expose-Headers", exposeHeaders)
+			}
+			c.Next()
+			return
+		}
+
+		if !p.allowMethod(c.GetHeader("Access-Control-Request-Method")) {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		c.Header("Access-Control-Allow-Methods", allowMethods)
+		if allowHeaders != "" {
+			c.Header("Access-Control-Allow-Headers", allowHeaders)
+		}
+		if p.MaxAge > 0 {
+			c.Header("Access-Control-Max-Age", maxAge)
+		}
+		c.AbortWithStatus(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,28 @@
+// Package middleware berisi middleware Gin yang dipakai bersama oleh semua route group.
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecated menandai route sebagai usang dengan header Deprecation (RFC 9745) dan Sunset (RFC 8594).
+// successorPrefix ditempelkan di depan path request sebagai Link rel="successor-version".
+func Deprecated(deprecatedAt, sunset time.Time, successorPrefix string) gin.HandlerFunc {
+	deprecation := fmt.Sprintf("@%d", deprecatedAt.Unix())
+	sunsetDate := sunset.UTC().Format(http.TimeFormat)
+
+	return func(c *gin.Context) {
+		c.Header("Deprecation", deprecation)
+		c.Header("Sunset", sunsetDate)
+		successor := successorPrefix + c.Request.URL.Path
+		if c.Request.URL.RawQuery != "" {
+			successor += "?" + c.Request.URL.RawQuery
+		}
+		c.Header("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+		c.Next()
+	}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Timeouts adalah deadline per route. Kunci Routes berupa "METHOD /path" atau "/path" (path Gin tanpa prefix versi,
+// misal "GET /stats/characters" atau "/search"); route lain memakai Default. Durasi 0 berarti tanpa deadline.
+type Timeouts struct {
+	Default time.Duration
+	Routes  map[string]time.Duration
+}
+
+// ParseTimeouts membaca daftar "GET /search=5s,/stats/characters=30s"
+func ParseTimeouts(defaultTimeout time.Duration, routes string) (Timeouts, error) {
+	t := Timeouts{Default: defaultTimeout, Routes: map[string]time.Duration{}}
+	for _, entry := range strings.Split(routes, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return Timeouts{}, fmt.Errorf("invalid route timeout %q", entry)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return Timeouts{}, fmt.Errorf("invalid route timeout %q: %v", entry, err)
+		}
+		t.Routes[strings.Join(strings.Fields(key), " ")] = d
+	}
+	return t, nil
+}
+
+// For mengembalikan deadline route; kunci dengan method lebih diutamakan daripada kunci path saja
+func (t Timeouts) For(method, path string) time.Duration {
+	if d, ok := t.Routes[method+" "+path]; ok {
+		return d
+	}
+	if d, ok := t.Routes[path]; ok {
+		return d
+	}
+	return t.Default
+}
+
+// Timeout memasang deadline di context request. Service dan repository menerima context ini,
+// sehingga query MongoDB ikut dibatalkan saat deadline habis atau client memutus koneksi.
+func Timeout(d time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if d <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Dokumen lama yang dibuat tanpa images/jutsu/abilities tersimpan sebagai null,
+// sehingga client menerima "images": null. Samakan jadi array kosong.
+// Tidak ada Down: setelah backfill, dokumen yang semula null tidak bisa dibedakan dari yang memang kosong.
+func init() {
+	Register(Migration{
+		Version:     1,
+		Description: "backfill null list fields with empty arrays",
+		Up: func(ctx context.Context, t Target) error {
+			if err := backfillArrays(ctx, t.Characters, "images", "jutsu"); err != nil {
+				return err
+			}
+			return backfillArrays(ctx, t.TailedBeasts, "images", "abilities")
+		},
+	})
+}
+
+func backfillArrays(ctx context.Context, collection *mongo.Collection, fields ...string) error {
+	for _, field := range fields {
+		filter := bson.M{field: nil}
+		update := bson.M{"$set": bson.M{field: bson.A{}}}
+		if _, err := collection.UpdateMany(ctx, filter, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
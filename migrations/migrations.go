@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Target berisi database dan collection yang boleh diubah oleh migration.
+// Nama collection karakter/tailed beast dikonfigurasi lewat env, jadi migration tidak boleh hard-code nama.
+type Target struct {
+	DB           *mongo.Database
+	Characters   *mongo.Collection
+	TailedBeasts *mongo.Collection
+}
+
+// Func adalah satu langkah migration (up atau down)
+type Func func(ctx context.Context, t Target) error
+
+// Migration adalah perubahan schema yang berurutan berdasarkan Version.
+// Down boleh nil untuk migration yang tidak bisa dibalik.
+type Migration struct {
+	Version     int64
+	Description string
+	Up          Func
+	Down        Func
+}
+
+var (
+	ErrIrreversible = errors.New("migration cannot be reverted")
+	ErrLocked       = errors.New("migrations are locked by another instance")
+	ErrLockLost     = errors.New("migration lock expired or was taken over by another instance")
+)
+
+var registry = map[int64]Migration{}
+
+// Register mendaftarkan migration; dipanggil dari init() di file migration masing-masing
+func Register(m Migration) {
+	if m.Version <= 0 {
+		panic(fmt.Sprintf("migrations: invalid version %d", m.Version))
+	}
+	if m.Up == nil {
+		panic(fmt.Sprintf("migrations: version %d has no Up", m.Version))
+	}
+	if _, ok := registry[m.Version]; ok {
+		panic(fmt.Sprintf("migrations: version %d registered twice", m.Version))
+	}
+	registry[m.Version] = m
+}
+
+// All mengembalikan semua migration terdaftar, urut dari versi terkecil
+func All() []Migration {
+	all := make([]Migration, 0, len(registry))
+	for _, m := range registry {
+		all = append(all, m)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Version < all[j].Version })
+	return all
+}
+
+// Status adalah kondisi satu migration terhadap database
+type Status struct {
+	Version     int64      `json:"version"`
+	Description string     `json:"description"`
+	Applied     bool       `json:"applied"`
+	AppliedAt   *time.Time `json:"appliedAt,omitempty"`
+}
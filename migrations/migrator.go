@@ -0,0 +1,194 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	// lockRetryInterval adalah jeda sebelum mencoba mengambil lock lagi
+	lockRetryInterval = time.Second
+	// lockRenewInterval cukup pendek supaya lock diperpanjang beberapa kali sebelum lockTTL habis
+	lockRenewInterval = lockTTL / 3
+)
+
+// Migrator menjalankan migration dan mencatat versi yang sudah diterapkan di schema_migrations
+type Migrator struct {
+	target     Target
+	migrations []Migration
+	store      store
+	owner      string
+	// renewals memberi sinyal kapan lock diperpanjang selama migration berjalan; diganti di test
+	renewals func() (<-chan time.Time, func())
+}
+
+func NewMigrator(target Target) *Migrator {
+	host, _ := os.Hostname()
+	return &Migrator{
+		target:     target,
+		migrations: All(),
+		store: &mongoStore{
+			history: target.DB.Collection(historyCollection),
+			locks:   target.DB.Collection(lockCollection),
+		},
+		owner:    fmt.Sprintf("%s/%d", host, os.Getpid()),
+		renewals: renewTicker,
+	}
+}
+
+func renewTicker() (<-chan time.Time, func()) {
+	t := time.NewTicker(lockRenewInterval)
+	return t.C, t.Stop
+}
+
+// Status mengembalikan semua migration beserta status applied-nya
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.store.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		s := Status{Version: mig.Version, Description: mig.Description}
+		if r, ok := applied[mig.Version]; ok {
+			appliedAt := r.AppliedAt
+			s.Applied = true
+			s.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+// Pending mengembalikan migration yang belum diterapkan
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.store.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; !ok {
+			pending = append(pending, mig)
+		}
+	}
+	return pending, nil
+}
+
+// Up menerapkan semua migration yang belum diterapkan, berurutan
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range pending {
+			if err := mig.Up(ctx, m.target); err != nil {
+				return fmt.Errorf("migration %d (%s): %w", mig.Version, mig.Description, err)
+			}
+
+			r := record{Version: mig.Version, Description: mig.Description, AppliedAt: time.Now().UTC()}
+			if err := m.store.insert(ctx, r); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down membatalkan sejumlah steps migration terakhir yang sudah diterapkan
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.store.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if mig.Down == nil {
+				return fmt.Errorf("migration %d (%s): %w", mig.Version, mig.Description, ErrIrreversible)
+			}
+			if err := mig.Down(ctx, m.target); err != nil {
+				return fmt.Errorf("migration %d (%s): %w", mig.Version, mig.Description, err)
+			}
+			if err := m.store.remove(ctx, mig.Version); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// withLock memastikan hanya satu instance yang menjalankan migration.
+// Instance lain menunggu sampai lock dilepas atau ctx selesai.
+// Selama fn berjalan lock diperpanjang berkala; jika gagal, ctx fn dibatalkan dan ErrLockLost dikembalikan
+// supaya migration tidak berlanjut bersamaan dengan instance yang mengambil alih lock.
+func (m *Migrator) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	for {
+		err := m.store.acquire(ctx, m.owner)
+		if err == nil {
+			break
+		}
+		if err != ErrLocked {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ErrLocked
+		case <-time.After(lockRetryInterval):
+		}
+	}
+	defer m.store.release(m.owner)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	// heartbeat harus berhenti sebelum lock dilepas
+	stopped := make(chan struct{})
+	defer func() { <-stopped }()
+	done := make(chan struct{})
+	defer close(done)
+
+	ticks, stop := m.renewals()
+	go func() {
+		defer close(stopped)
+		defer stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticks:
+				if err := m.store.renew(ctx, m.owner); err != nil {
+					if !errors.Is(err, ErrLockLost) {
+						err = fmt.Errorf("%w: %v", ErrLockLost, err)
+					}
+					cancel(err)
+					return
+				}
+			}
+		}
+	}()
+
+	err := fn(ctx)
+	if cause := context.Cause(ctx); errors.Is(cause, ErrLockLost) {
+		return cause
+	}
+	return err
+}
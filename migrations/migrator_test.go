@@ -0,0 +1,382 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStore adalah store di memori untuk test; lock hanya bisa dipegang satu owner dan kedaluwarsa
+// setelah lockTTL menurut clock
+type memoryStore struct {
+	mu        sync.Mutex
+	records   map[int64]record
+	owner     string
+	expiresAt time.Time
+	clock     *fakeClock
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: map[int64]record{}, clock: &fakeClock{now: time.Now()}}
+}
+
+func (s *memoryStore) applied(ctx context.Context) (map[int64]record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	applied := make(map[int64]record, len(s.records))
+	for v, r := range s.records {
+		applied[v] = r
+	}
+	return applied, nil
+}
+
+func (s *memoryStore) insert(ctx context.Context, r record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[r.Version] = r
+	return nil
+}
+
+func (s *memoryStore) remove(ctx context.Context, version int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, version)
+	return nil
+}
+
+func (s *memoryStore) acquire(ctx context.Context, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	if s.owner != "" && now.Before(s.expiresAt) {
+		return ErrLocked
+	}
+	s.owner = owner
+	s.expiresAt = now.Add(lockTTL)
+	return nil
+}
+
+func (s *memoryStore) renew(ctx context.Context, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	if s.owner != owner || !now.Before(s.expiresAt) {
+		return ErrLockLost
+	}
+	s.expiresAt = now.Add(lockTTL)
+	return nil
+}
+
+func (s *memoryStore) release(owner string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.owner == owner {
+		s.owner = ""
+	}
+}
+
+// fakeClock adalah waktu yang hanya maju lewat Advance
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// journal mencatat urutan langkah migration yang dijalankan
+type journal struct {
+	mu    sync.Mutex
+	steps []string
+}
+
+func (j *journal) step(name string) Func {
+	return func(ctx context.Context, t Target) error {
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		j.steps = append(j.steps, name)
+		return nil
+	}
+}
+
+func (j *journal) take() []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	steps := j.steps
+	j.steps = nil
+	return steps
+}
+
+// newTestMigrator tidak pernah memperpanjang lock kecuali renewals diganti
+func newTestMigrator(store store, owner string, migrations ...Migration) *Migrator {
+	return &Migrator{migrations: migrations, store: store, owner: owner, renewals: noRenewals}
+}
+
+func noRenewals() (<-chan time.Time, func()) {
+	return nil, func() {}
+}
+
+// manualRenewals mengembalikan renewals yang hanya berdetak saat test mengirim ke channel-nya
+func manualRenewals() (chan time.Time, func() (<-chan time.Time, func())) {
+	ticks := make(chan time.Time)
+	return ticks, func() (<-chan time.Time, func()) { return ticks, func() {} }
+}
+
+func versions(migrations []Migration) []int64 {
+	var vs []int64
+	for _, m := range migrations {
+		vs = append(vs, m.Version)
+	}
+	return vs
+}
+
+func TestMigratorUpAndDownOrder(t *testing.T) {
+	j := &journal{}
+	ctx := context.Background()
+	m := newTestMigrator(newMemoryStore(), "test",
+		Migration{Version: 1, Description: "one", Up: j.step("up 1"), Down: j.step("down 1")},
+		Migration{Version: 2, Description: "two", Up: j.step("up 2"), Down: j.step("down 2")},
+		Migration{Version: 3, Description: "three", Up: j.step("up 3"), Down: j.step("down 3")},
+	)
+
+	done, err := m.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := j.take(), []string{"up 1", "up 2", "up 3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Up ran %v, want %v", got, want)
+	}
+	if got := versions(done); !reflect.DeepEqual(got, []int64{1, 2, 3}) {
+		t.Fatalf("Up returned %v", got)
+	}
+
+	// migration yang sudah diterapkan tidak dijalankan lagi
+	if done, err := m.Up(ctx); err != nil || len(done) != 0 || len(j.take()) != 0 {
+		t.Fatalf("second Up applied %v, err %v", versions(done), err)
+	}
+
+	done, err = m.Down(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := j.take(), []string{"down 3", "down 2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Down ran %v, want %v", got, want)
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(pending); !reflect.DeepEqual(got, []int64{2, 3}) {
+		t.Fatalf("pending after Down = %v, want [2 3]", got)
+	}
+
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := j.take(), []string{"up 2", "up 3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Up after Down ran %v, want %v", got, want)
+	}
+}
+
+func TestMigratorStopsAtFailureAndIrreversible(t *testing.T) {
+	j := &journal{}
+	ctx := context.Background()
+	store := newMemoryStore()
+	m := newTestMigrator(store, "test",
+		Migration{Version: 1, Description: "one", Up: j.step("up 1")},
+		Migration{Version: 2, Description: "two", Up: func(ctx context.Context, t Target) error { return errors.New("boom") }},
+		Migration{Version: 3, Description: "three", Up: j.step("up 3")},
+	)
+
+	done, err := m.Up(ctx)
+	if err == nil || err.Error() != "migration 2 (two): boom" {
+		t.Fatalf("Up err = %v", err)
+	}
+	if got := versions(done); !reflect.DeepEqual(got, []int64{1}) {
+		t.Fatalf("Up applied %v, want [1]", got)
+	}
+	if got := j.take(); !reflect.DeepEqual(got, []string{"up 1"}) {
+		t.Fatalf("Up ran %v after a failure", got)
+	}
+
+	if _, err := m.Down(ctx, 1); !errors.Is(err, ErrIrreversible) {
+		t.Fatalf("Down of a migration without Down returned %v", err)
+	}
+	if _, ok := store.records[1]; !ok {
+		t.Fatal("irreversible migration was removed from history")
+	}
+	if store.owner != "" {
+		t.Fatalf("lock still held by %q after an error", store.owner)
+	}
+}
+
+func TestMigratorWaitsForLock(t *testing.T) {
+	j := &journal{}
+	store := newMemoryStore()
+	migration := Migration{Version: 1, Description: "one", Up: j.step("up 1")}
+
+	// instance lain sedang memegang lock
+	if err := store.acquire(context.Background(), "other"); err != nil {
+		t.Fatal(err)
+	}
+
+	m := newTestMigrator(store, "test", migration)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := m.Up(ctx); err != ErrLocked {
+		t.Fatalf("Up while locked returned %v, want ErrLocked", err)
+	}
+	if len(j.take()) != 0 {
+		t.Fatal("migration ran without the lock")
+	}
+
+	// setelah lock dilepas, instance yang menunggu melanjutkan
+	done := make(chan error, 1)
+	go func() {
+		_, err := m.Up(context.Background())
+		done <- err
+	}()
+	store.release("other")
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(2*lockRetryInterval + time.Second):
+		t.Fatal("Up did not acquire the released lock")
+	}
+	if got := j.take(); !reflect.DeepEqual(got, []string{"up 1"}) {
+		t.Fatalf("Up ran %v", got)
+	}
+}
+
+func TestMigratorRunsEachMigrationOnceAcrossInstances(t *testing.T) {
+	j := &journal{}
+	store := newMemoryStore()
+	// Up yang lambat memastikan instance lain mencoba mengambil lock saat migration masih berjalan
+	migration := Migration{Version: 1, Description: "one", Up: func(ctx context.Context, t Target) error {
+		time.Sleep(20 * time.Millisecond)
+		return j.step("up 1")(ctx, t)
+	}}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := newTestMigrator(store, fmt.Sprintf("instance-%d", i), migration).Up(context.Background())
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := j.take(); !reflect.DeepEqual(got, []string{"up 1"}) {
+		t.Fatalf("migration ran %v, want once", got)
+	}
+}
+
+func TestMigratorRenewsLockWhileRunning(t *testing.T) {
+	store := newMemoryStore()
+	clock := store.clock
+	ticks, renewals := manualRenewals()
+	running, finish := make(chan struct{}), make(chan struct{})
+	migration := Migration{Version: 1, Description: "slow", Up: func(ctx context.Context, t Target) error {
+		close(running)
+		<-finish
+		return nil
+	}}
+	m := newTestMigrator(store, "test", migration)
+	m.renewals = renewals
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := m.Up(context.Background())
+		done <- err
+	}()
+	<-running
+
+	// migration berjalan lebih lama dari lockTTL, tapi setiap heartbeat memperpanjang lock
+	for i := 0; i < 4; i++ {
+		clock.Advance(lockRenewInterval)
+		ticks <- clock.Now()
+	}
+	clock.Advance(lockRenewInterval)
+	if err := store.acquire(context.Background(), "other"); err != ErrLocked {
+		t.Fatalf("acquire of a renewed lock returned %v, want ErrLocked", err)
+	}
+
+	close(finish)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigratorStopsWhenLockIsLost(t *testing.T) {
+	j := &journal{}
+	store := newMemoryStore()
+	clock := store.clock
+	ticks, renewals := manualRenewals()
+	running := make(chan struct{})
+	migrations := []Migration{
+		{Version: 1, Description: "slow", Up: func(ctx context.Context, t Target) error {
+			close(running)
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		{Version: 2, Description: "two", Up: j.step("up 2")},
+	}
+	m := newTestMigrator(store, "test", migrations...)
+	m.renewals = renewals
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := m.Up(context.Background())
+		done <- err
+	}()
+	<-running
+
+	// heartbeat terlambat: lock sudah kedaluwarsa dan diambil alih instance lain
+	clock.Advance(lockTTL)
+	if err := store.acquire(context.Background(), "other"); err != nil {
+		t.Fatal(err)
+	}
+	ticks <- clock.Now()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrLockLost) {
+			t.Fatalf("Up returned %v, want ErrLockLost", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Up kept running after the lock was lost")
+	}
+	if got := j.take(); len(got) != 0 {
+		t.Fatalf("migrations ran after the lock was lost: %v", got)
+	}
+	if applied, _ := store.applied(context.Background()); len(applied) != 0 {
+		t.Fatalf("recorded %v after the lock was lost", applied)
+	}
+	// lock milik instance lain tidak ikut dilepas
+	if store.owner != "other" {
+		t.Fatalf("lock owner = %q, want other", store.owner)
+	}
+}
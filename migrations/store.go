@@ -0,0 +1,115 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	historyCollection = "schema_migrations"
+	lockCollection    = "schema_migrations_lock"
+	lockID            = "migrations"
+	lockTTL           = 10 * time.Minute
+)
+
+type record struct {
+	Version     int64     `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedAt"`
+}
+
+type lock struct {
+	ID        string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	LockedAt  time.Time `bson:"lockedAt"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+// store menyimpan riwayat migration dan lock antar instance
+type store interface {
+	applied(ctx context.Context) (map[int64]record, error)
+	insert(ctx context.Context, r record) error
+	remove(ctx context.Context, version int64) error
+	// acquire mengembalikan ErrLocked jika lock dipegang owner lain
+	acquire(ctx context.Context, owner string) error
+	// renew memperpanjang lock owner; ErrLockLost jika lock sudah kedaluwarsa atau dipegang owner lain
+	renew(ctx context.Context, owner string) error
+	release(owner string)
+}
+
+// mongoStore menyimpan riwayat di schema_migrations dan lock di schema_migrations_lock
+type mongoStore struct {
+	history *mongo.Collection
+	locks   *mongo.Collection
+}
+
+func (s *mongoStore) applied(ctx context.Context) (map[int64]record, error) {
+	cursor, err := s.history.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	applied := map[int64]record{}
+	for cursor.Next(ctx) {
+		var r record
+		if err := cursor.Decode(&r); err != nil {
+			return nil, err
+		}
+		applied[r.Version] = r
+	}
+	return applied, cursor.Err()
+}
+
+func (s *mongoStore) insert(ctx context.Context, r record) error {
+	_, err := s.history.InsertOne(ctx, r)
+	return err
+}
+
+func (s *mongoStore) remove(ctx context.Context, version int64) error {
+	_, err := s.history.DeleteOne(ctx, bson.M{"_id": version})
+	return err
+}
+
+// acquire mengambil lock; lock yang kedaluwarsa (instance mati di tengah jalan) boleh diambil alih
+func (s *mongoStore) acquire(ctx context.Context, owner string) error {
+	now := time.Now().UTC()
+	l := lock{ID: lockID, Owner: owner, LockedAt: now, ExpiresAt: now.Add(lockTTL)}
+
+	_, err := s.locks.InsertOne(ctx, l)
+	if mongo.IsDuplicateKeyError(err) {
+		res, err := s.locks.ReplaceOne(ctx, bson.M{"_id": lockID, "expiresAt": bson.M{"$lt": now}}, l)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return ErrLocked
+		}
+		return nil
+	}
+	return err
+}
+
+// renew hanya memperpanjang lock yang masih dipegang owner dan belum kedaluwarsa
+func (s *mongoStore) renew(ctx context.Context, owner string) error {
+	now := time.Now().UTC()
+	res, err := s.locks.UpdateOne(ctx,
+		bson.M{"_id": lockID, "owner": owner, "expiresAt": bson.M{"$gte": now}},
+		bson.M{"$set": bson.M{"expiresAt": now.Add(lockTTL)}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// release dijalankan dengan context baru supaya lock tetap dilepas walaupun ctx migration sudah selesai
+func (s *mongoStore) release(owner string) {
+	s.locks.DeleteOne(context.Background(), bson.M{"_id": lockID, "owner": owner})
+}
@@ -0,0 +1,18 @@
+package models
+
+// FacetBucket adalah jumlah dokumen untuk satu nilai field, misal {"value": "Uchiha Clan", "count": 23}
+type FacetBucket struct {
+	Value string `json:"value" bson:"_id"`
+	Count int64  `json:"count" bson:"count"`
+}
+
+// Facets memetakan nama facet ke bucket-bucketnya, urut dari count terbanyak
+type Facets map[string][]FacetBucket
+
+// FacetScope menentukan dokumen mana yang dihitung: filter per field (nama facet → nilai),
+// daftar slug hasil pencarian, dan/atau query full-text
+type FacetScope struct {
+	Filters map[string]string
+	Slugs   []string
+	Text    string
+}
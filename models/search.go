@@ -0,0 +1,21 @@
+package models
+
+// Highlight adalah potongan teks dari satu field yang cocok dengan query, kata yang cocok dibungkus <em>
+type Highlight struct {
+	Field   string `json:"field"`
+	Snippet string `json:"snippet"`
+}
+
+// CharacterHit adalah hasil full-text search karakter beserta skor relevansinya
+type CharacterHit struct {
+	Character  `bson:",inline"`
+	Score      float64     `json:"score" bson:"score"`
+	Highlights []Highlight `json:"highlights" bson:"-"`
+}
+
+// TailedBeastHit adalah hasil full-text search tailed beast beserta skor relevansinya
+type TailedBeastHit struct {
+	TailedBeast `bson:",inline"`
+	Score       float64     `json:"score" bson:"score"`
+	Highlights  []Highlight `json:"highlights" bson:"-"`
+}
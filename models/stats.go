@@ -0,0 +1,59 @@
+package models
+
+// AverageBucket adalah rata-rata sebuah nilai numerik per grup
+type AverageBucket struct {
+	Value   string  `json:"value" bson:"_id"`
+	Average float64 `json:"average" bson:"average"`
+	Count   int64   `json:"count" bson:"count"`
+}
+
+// JutsuCount adalah jumlah jutsu yang dimiliki satu karakter
+type JutsuCount struct {
+	Slug  string `json:"slug" bson:"slug"`
+	Name  string `json:"name" bson:"name"`
+	Count int64  `json:"count" bson:"count"`
+}
+
+// StatusRatio membandingkan karakter yang masih hidup dan yang sudah meninggal
+type StatusRatio struct {
+	Alive       int64   `json:"alive"`
+	Deceased    int64   `json:"deceased"`
+	Unknown     int64   `json:"unknown"`
+	LivingRatio float64 `json:"livingRatio"`
+}
+
+// CharacterGroup adalah statistik karakter untuk satu nilai groupBy
+type CharacterGroup struct {
+	Value         string   `json:"value" bson:"_id"`
+	Count         int64    `json:"count" bson:"count"`
+	AverageHeight *float64 `json:"averageHeight" bson:"averageHeight"`
+	AverageJutsu  float64  `json:"averageJutsu" bson:"averageJutsu"`
+}
+
+// CharacterStats adalah ringkasan statistik untuk dashboard karakter
+type CharacterStats struct {
+	Total               int64            `json:"total"`
+	ByVillage           []FacetBucket    `json:"byVillage"`
+	ByRank              []FacetBucket    `json:"byRank"`
+	Status              StatusRatio      `json:"status"`
+	AverageHeightByClan []AverageBucket  `json:"averageHeightByClan"`
+	JutsuCounts         []JutsuCount     `json:"jutsuCounts"`
+	GroupBy             string           `json:"groupBy,omitempty"`
+	Groups              []CharacterGroup `json:"groups,omitempty"`
+}
+
+// TailedBeastGroup adalah statistik tailed beast untuk satu nilai groupBy
+type TailedBeastGroup struct {
+	Value            string  `json:"value" bson:"_id"`
+	Count            int64   `json:"count" bson:"count"`
+	AverageAbilities float64 `json:"averageAbilities" bson:"averageAbilities"`
+}
+
+// TailedBeastStats adalah ringkasan statistik untuk dashboard tailed beast
+type TailedBeastStats struct {
+	Total            int64              `json:"total"`
+	ByRank           []FacetBucket      `json:"byRank"`
+	AverageAbilities float64            `json:"averageAbilities"`
+	GroupBy          string             `json:"groupBy,omitempty"`
+	Groups           []TailedBeastGroup `json:"groups,omitempty"`
+}
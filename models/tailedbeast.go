@@ -7,4 +7,5 @@ type TailedBeast struct {
 	Rank        string   `json:"rank" bson:"rank"`
 	Abilities   []string `json:"abilities" bson:"abilities"`
 	Personality string   `json:"personality" bson:"personality"`
+	Jinchuriki  string   `json:"jinchuriki" bson:"jinchuriki"` // slug karakter host
 }
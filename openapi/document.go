@@ -0,0 +1,280 @@
+// Package openapi membangun dokumen OpenAPI 3.1 dari route Gin yang benar-benar terdaftar
+// dan struct di models, lalu menyajikannya di /openapi.json beserta Swagger UI di /docs.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/response"
+)
+
+// Param adalah parameter query sebuah operasi
+type Param struct {
+	Name        string
+	Type        string // string, integer atau number
+	Description string
+	Required    bool
+}
+
+// Operation adalah metadata dokumentasi untuk satu route (method + path Gin, misal /character/:slug)
+type Operation struct {
+	Method  string
+	Path    string
+	Summary string
+	Tag     string
+	Query   []Param
+	// Body adalah contoh nilai request body (misal models.Character{}), nil jika tanpa body
+	Body interface{}
+	// Status adalah status sukses; Result adalah contoh nilai "result" di respons, nil jika tanpa body
+	Status int
+	Result interface{}
+	// Linked berarti setiap objek di result mendapat "_links" (HAL)
+	Linked bool
+	// Deprecated menandai alias lama yang akan dihapus; alias lama memakai bentuk respons lama
+	Deprecated bool
+}
+
+// Info adalah metadata dokumen
+type Info struct {
+	Title   string
+	Version string
+}
+
+var pathParam = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// OpenAPIPath mengubah path Gin (/character/:slug) menjadi path OpenAPI (/character/{slug})
+func OpenAPIPath(ginPath string) string {
+	return pathParam.ReplaceAllString(ginPath, "{$1}")
+}
+
+func key(method, path string) string {
+	return method + " " + path
+}
+
+// Prefix mengembalikan salinan ops dengan path diawali prefix, misal "/v1"
+func Prefix(prefix string, ops []Operation) []Operation {
+	out := make([]Operation, len(ops))
+	for i, op := range ops {
+		op.Path = prefix + op.Path
+		out[i] = op
+	}
+	return out
+}
+
+// Deprecate mengembalikan salinan ops yang ditandai deprecated
+func Deprecate(ops []Operation) []Operation {
+	out := make([]Operation, len(ops))
+	for i, op := range ops {
+		op.Deprecated = true
+		out[i] = op
+	}
+	return out
+}
+
+// Diff membandingkan route yang terdaftar dengan operasi yang didokumentasikan.
+// Hasilnya kosong jika keduanya sama persis. Route OPTIONS (preflight CORS) tidak didokumentasikan.
+func Diff(routes gin.RoutesInfo, ops []Operation) []string {
+	registered := map[string]bool{}
+	for _, r := range routes {
+		if r.Method == http.MethodOptions {
+			continue
+		}
+		registered[key(r.Method, r.Path)] = true
+	}
+	documented := map[string]bool{}
+	for _, op := range ops {
+		documented[key(op.Method, op.Path)] = true
+	}
+
+	var problems []string
+	for k := range registered {
+		if !documented[k] {
+			problems = append(problems, "route is not documented: "+k)
+		}
+	}
+	for k := range documented {
+		if !registered[k] {
+			problems = append(problems, "documented route is not registered: "+k)
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// Build membuat dokumen OpenAPI dari route yang terdaftar. Route tanpa metadata tetap masuk
+// dengan deskripsi minimal sehingga dokumen tidak pernah kehilangan endpoint.
+func Build(info Info, routes gin.RoutesInfo, ops []Operation) map[string]interface{} {
+	byKey := map[string]Operation{}
+	for _, op := range ops {
+		byKey[key(op.Method, op.Path)] = op
+	}
+
+	schemas := newSchemaRegistry()
+	paths := map[string]map[string]interface{}{}
+	for _, r := range routes {
+		if r.Method == http.MethodOptions {
+			continue
+		}
+		op, ok := byKey[key(r.Method, r.Path)]
+		if !ok {
+			op = Operation{Method: r.Method, Path: r.Path, Summary: "Undocumented route", Status: http.StatusOK}
+		}
+
+		path := OpenAPIPath(r.Path)
+		if paths[path] == nil {
+			paths[path] = map[string]interface{}{}
+		}
+		paths[path][strings.ToLower(r.Method)] = buildOperation(op, schemas)
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.1.0",
+		"info": map[string]interface{}{
+			"title":   info.Title,
+			"version": info.Version,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas.schemas,
+		},
+	}
+}
+
+func buildOperation(op Operation, schemas *schemaRegistry) map[string]interface{} {
+	var parameters []map[string]interface{}
+	for _, m := range pathParam.FindAllStringSubmatch(op.Path, -1) {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     m[1],
+			"in":       "path",
+			"required": true,
+			"schema":   Schema{"type": "string"},
+		})
+	}
+	for _, p := range op.Query {
+		typ := p.Type
+		if typ == "" {
+			typ = "string"
+		}
+		param := map[string]interface{}{
+			"name":     p.Name,
+			"in":       "query",
+			"required": p.Required,
+			"schema":   Schema{"type": typ},
+		}
+		if p.Description != "" {
+			param["description"] = p.Description
+		}
+		parameters = append(parameters, param)
+	}
+
+	status := op.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := map[string]interface{}{"description": http.StatusText(status)}
+	if op.Result != nil {
+		success["content"] = jsonContent(successSchema(op, schemas))
+	}
+
+	errorResponse := map[string]interface{}{
+		"description": "Error",
+		"content":     jsonContent(errorSchema(op, schemas)),
+	}
+
+	operation := map[string]interface{}{
+		"summary":     op.Summary,
+		"operationId": operationID(op),
+		"responses": map[string]interface{}{
+			fmt.Sprint(status): success,
+			"default":          errorResponse,
+		},
+	}
+	if op.Deprecated {
+		operation["deprecated"] = true
+	}
+	if op.Tag != "" {
+		operation["tags"] = []string{op.Tag}
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+	if op.Body != nil {
+		operation["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content":  jsonContent(schemas.schemaOf(reflect.TypeOf(op.Body))),
+		}
+	}
+	return operation
+}
+
+// successSchema adalah envelope respons; alias deprecated masih memakai bentuk lama {message, result}
+func successSchema(op Operation, schemas *schemaRegistry) Schema {
+	result := schemas.schemaOf(reflect.TypeOf(op.Result))
+	if op.Deprecated {
+		return Schema{
+			"type": "object",
+			"properties": map[string]Schema{
+				"message": {"type": "string"},
+				"result":  result,
+			},
+		}
+	}
+	if op.Linked {
+		result = withLinks(result, schemas)
+	}
+	return Schema{
+		"type": "object",
+		"properties": map[string]Schema{
+			"data":   result,
+			"meta":   schemas.schemaOf(reflect.TypeOf(response.Meta{})),
+			"links":  schemas.schemaOf(reflect.TypeOf(response.Links{})),
+			"errors": schemas.schemaOf(reflect.TypeOf([]response.Error{})),
+		},
+	}
+}
+
+// withLinks menambahkan properti _links ke schema objek, atau ke item jika schema berupa array
+func withLinks(schema Schema, schemas *schemaRegistry) Schema {
+	if schema["type"] == "array" {
+		return Schema{"type": "array", "items": withLinks(schema["items"].(Schema), schemas)}
+	}
+	return Schema{"allOf": []Schema{schema, {
+		"type":       "object",
+		"properties": map[string]Schema{"_links": schemas.schemaOf(reflect.TypeOf(response.Links{}))},
+	}}}
+}
+
+func errorSchema(op Operation, schemas *schemaRegistry) Schema {
+	if op.Deprecated {
+		return Schema{
+			"type":       "object",
+			"properties": map[string]Schema{"error": {"type": "string"}},
+		}
+	}
+	return Schema{
+		"type": "object",
+		"properties": map[string]Schema{
+			"meta":   schemas.schemaOf(reflect.TypeOf(response.Meta{})),
+			"errors": schemas.schemaOf(reflect.TypeOf([]response.Error{})),
+		},
+	}
+}
+
+func jsonContent(schema Schema) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+// operationID membuat id unik dari method dan path, misal GET /character/:slug → get_character_slug
+func operationID(op Operation) string {
+	id := strings.ToLower(op.Method) + strings.NewReplacer("/", "_", ":", "", "*", "", "-", "_", ".", "_").Replace(op.Path)
+	return strings.TrimSuffix(id, "_")
+}
@@ -0,0 +1,64 @@
+package openapi
+
+import (
+	"embed"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed swagger.html
+var swaggerPage []byte
+
+// swaggerUI berisi salinan swagger-ui-dist 5.18.2 (Apache-2.0) supaya /docs tidak bergantung pada CDN
+//
+//go:embed swagger-ui/swagger-ui.css swagger-ui/swagger-ui-bundle.js
+var swaggerUI embed.FS
+
+type Handler struct {
+	Info       Info
+	Operations []Operation
+	// Routes dibaca saat request pertama supaya semua route sudah terdaftar
+	Routes func() gin.RoutesInfo
+
+	once sync.Once
+	doc  map[string]interface{}
+}
+
+func NewHandler(info Info, routes func() gin.RoutesInfo, ops []Operation) *Handler {
+	return &Handler{
+		Info:       info,
+		Operations: ops,
+		Routes:     routes,
+	}
+}
+
+// Spec handler untuk dokumen OpenAPI dalam format JSON
+func (h *Handler) Spec(c *gin.Context) {
+	h.once.Do(func() {
+		h.doc = Build(h.Info, h.Routes(), h.Operations)
+	})
+	c.JSON(http.StatusOK, h.doc)
+}
+
+// Docs handler untuk Swagger UI yang membaca /openapi.json
+func (h *Handler) Docs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", swaggerPage)
+}
+
+// Asset handler untuk file Swagger UI yang di-embed, misal /docs/assets/swagger-ui.css
+func (h *Handler) Asset(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.FileFromFS(path.Join("swagger-ui", c.Param("file")), http.FS(swaggerUI))
+}
+
+// Operations mendokumentasikan route dokumentasi itu sendiri
+func Operations() []Operation {
+	return []Operation{
+		{Method: http.MethodGet, Path: "/openapi.json", Tag: "docs", Summary: "OpenAPI document"},
+		{Method: http.MethodGet, Path: "/docs", Tag: "docs", Summary: "Swagger UI"},
+		{Method: http.MethodGet, Path: "/docs/assets/:file", Tag: "docs", Summary: "Swagger UI asset"},
+	}
+}
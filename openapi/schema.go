@@ -0,0 +1,95 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Schema adalah JSON Schema (dialek OpenAPI 3.1) dalam bentuk map supaya mudah di-encode
+type Schema map[string]interface{}
+
+// schemaRegistry mengumpulkan schema struct bernama ke components/schemas
+type schemaRegistry struct {
+	schemas map[string]Schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{schemas: map[string]Schema{}}
+}
+
+// schemaOf menurunkan schema dari tipe Go berdasarkan tag json.
+// Struct bernama didaftarkan sekali di components dan direferensikan lewat $ref.
+func (r *schemaRegistry) schemaOf(t reflect.Type) Schema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		s := r.schemaOf(t.Elem())
+		if ref, ok := s["$ref"]; ok {
+			return Schema{"oneOf": []Schema{{"$ref": ref}, {"type": "null"}}}
+		}
+		s["type"] = []interface{}{s["type"], "null"}
+		return s
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": r.schemaOf(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": r.schemaOf(t.Elem())}
+	case reflect.Interface:
+		return Schema{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		name := t.Name()
+		if _, ok := r.schemas[name]; !ok {
+			// daftarkan dulu supaya tipe rekursif tidak berputar tanpa henti
+			r.schemas[name] = Schema{}
+			r.schemas[name] = r.structSchema(t)
+		}
+		return Schema{"$ref": "#/components/schemas/" + name}
+	}
+	return Schema{}
+}
+
+func (r *schemaRegistry) structSchema(t reflect.Type) Schema {
+	properties := map[string]Schema{}
+	r.collectProperties(t, properties)
+	return Schema{"type": "object", "properties": properties}
+}
+
+// collectProperties meratakan field embedded seperti encoding/json
+func (r *schemaRegistry) collectProperties(t reflect.Type, properties map[string]Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				r.collectProperties(ft, properties)
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = r.schemaOf(field.Type)
+	}
+}
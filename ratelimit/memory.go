@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval adalah jarak minimal antar pembersihan bucket yang sudah penuh lagi
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// full adalah saat bucket terisi penuh; setelah itu bucket sama dengan bucket baru dan boleh dihapus
+	full time.Time
+}
+
+// MemoryStore menyimpan bucket di memori proses
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, quota Quota) (Result, error) {
+	now := s.now()
+	rate := quota.rate()
+	limit := float64(quota.Limit)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: limit, last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(limit, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+
+	var result Result
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - b.tokens) / rate)
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = seconds((limit - b.tokens) / rate)
+	b.full = now.Add(result.Reset)
+	return result, nil
+}
+
+// sweep menghapus bucket yang sudah penuh lagi supaya IP yang hanya sekali lewat tidak menumpuk di memori
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,93 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// clock adalah waktu palsu untuk MemoryStore.now
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time { return c.t }
+
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestStore() (*MemoryStore, *clock) {
+	c := &clock{t: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore()
+	s.now = c.now
+	return s, c
+}
+
+func TestMemoryStoreRefill(t *testing.T) {
+	s, c := newTestStore()
+	// 1 token per detik, burst 2
+	quota := Quota{Limit: 2, Window: 2 * time.Second}
+
+	steps := []struct {
+		advance time.Duration
+		want    Result
+	}{
+		{0, Result{Allowed: true, Remaining: 1, Reset: time.Second}},
+		{0, Result{Allowed: true, Remaining: 0, Reset: 2 * time.Second}},
+		{0, Result{Allowed: false, Remaining: 0, Reset: 2 * time.Second, RetryAfter: time.Second}},
+		{500 * time.Millisecond, Result{Allowed: false, Remaining: 0, Reset: 1500 * time.Millisecond, RetryAfter: 500 * time.Millisecond}},
+		{500 * time.Millisecond, Result{Allowed: true, Remaining: 0, Reset: 2 * time.Second}},
+		// refill tidak melebihi Limit
+		{time.Hour, Result{Allowed: true, Remaining: 1, Reset: time.Second}},
+	}
+	for i, step := range steps {
+		c.advance(step.advance)
+		got, err := s.Take(context.Background(), "ip:192.0.2.1", quota)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != step.want {
+			t.Errorf("step %d: got %+v, want %+v", i, got, step.want)
+		}
+	}
+}
+
+func TestMemoryStoreKeysAreIndependent(t *testing.T) {
+	s, _ := newTestStore()
+	quota := Quota{Limit: 1, Window: time.Minute}
+
+	if r, _ := s.Take(context.Background(), "a", quota); !r.Allowed {
+		t.Fatal("first request of a denied")
+	}
+	if r, _ := s.Take(context.Background(), "a", quota); r.Allowed || r.RetryAfter != time.Minute {
+		t.Fatalf("second request of a = %+v, want denied with Retry-After 1m", r)
+	}
+	if r, _ := s.Take(context.Background(), "b", quota); !r.Allowed {
+		t.Fatal("b must not share a's bucket")
+	}
+}
+
+func TestMemoryStoreSweepsFullBuckets(t *testing.T) {
+	s, c := newTestStore()
+	short := Quota{Limit: 1, Window: time.Second}
+	long := Quota{Limit: 1, Window: time.Hour}
+
+	s.Take(context.Background(), "passing", short)
+	s.Take(context.Background(), "throttled", long)
+	if len(s.buckets) != 2 {
+		t.Fatalf("buckets = %d, want 2", len(s.buckets))
+	}
+
+	// pembersihan berikutnya paling cepat sweepInterval setelah yang terakhir
+	c.advance(sweepInterval / 2)
+	s.Take(context.Background(), "other", short)
+	if _, ok := s.buckets["passing"]; !ok {
+		t.Fatal("bucket swept before sweepInterval")
+	}
+
+	c.advance(sweepInterval)
+	s.Take(context.Background(), "other", short)
+	if _, ok := s.buckets["passing"]; ok {
+		t.Error("full bucket was not swept")
+	}
+	if _, ok := s.buckets["throttled"]; !ok {
+		t.Error("bucket that is still refilling was swept")
+	}
+}
@@ -0,0 +1,70 @@
+// Package ratelimit membatasi jumlah request per API key atau IP client dengan token bucket.
+// Setiap class route (read, write, export) punya kuota dan bucket sendiri; state bucket disimpan di Store.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Class mengelompokkan route yang berbagi kuota
+type Class string
+
+const (
+	Read  Class = "read"
+	Write Class = "write"
+	// Export adalah request yang memuat seluruh collection, misal GET /character tanpa ?page&limit
+	Export Class = "export"
+)
+
+// Quota mengizinkan Limit request per Window. Bucket terisi ulang merata sepanjang Window,
+// dan Limit sekaligus menjadi batas burst.
+type Quota struct {
+	Limit  int
+	Window time.Duration
+}
+
+// ParseQuota membaca kuota seperti "100/1m" atau "5/1h"
+func ParseQuota(s string) (Quota, error) {
+	limit, window, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Quota{}, fmt.Errorf("invalid quota %q, want <requests>/<window> like 100/1m", s)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(limit))
+	if err != nil || n < 1 {
+		return Quota{}, fmt.Errorf("invalid quota %q: requests must be a positive number", s)
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(window))
+	if err != nil || d <= 0 {
+		return Quota{}, fmt.Errorf("invalid quota %q: window must be a positive duration", s)
+	}
+	return Quota{Limit: n, Window: d}, nil
+}
+
+func (q Quota) String() string {
+	return fmt.Sprintf("%d/%s", q.Limit, q.Window)
+}
+
+// rate adalah jumlah token yang kembali per detik
+func (q Quota) rate() float64 {
+	return float64(q.Limit) / q.Window.Seconds()
+}
+
+// Result adalah hasil pengambilan satu token
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// Reset adalah waktu sampai bucket penuh kembali
+	Reset time.Duration
+	// RetryAfter adalah waktu sampai token berikutnya tersedia; nol jika Allowed
+	RetryAfter time.Duration
+}
+
+// Store menyimpan bucket. MemoryStore cukup untuk satu instance; beberapa instance di belakang
+// load balancer butuh Store bersama (misal Redis) supaya kuota tidak dikalikan jumlah instance.
+type Store interface {
+	Take(ctx context.Context, key string, quota Quota) (Result, error)
+}
@@ -0,0 +1,31 @@
+package response
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// StatusClientClosedRequest adalah status non-standar (nginx) untuk request yang dibatalkan client sebelum selesai
+const StatusClientClosedRequest = 499
+
+// ContextStatus menerjemahkan context request yang sudah selesai menjadi status: 504 jika deadline habis,
+// 499 jika client memutus koneksi. ok=false jika context masih aktif.
+func ContextStatus(ctx context.Context) (status int, detail string, ok bool) {
+	err := ctx.Err()
+	switch {
+	case err == nil:
+		return 0, "", false
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, "Request timed out", true
+	default:
+		return StatusClientClosedRequest, "Client closed request", true
+	}
+}
+
+func statusText(status int) string {
+	if status == StatusClientClosedRequest {
+		return "Client Closed Request"
+	}
+	return http.StatusText(status)
+}
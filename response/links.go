@@ -0,0 +1,161 @@
+package response
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const prefixKey = "response.prefix"
+
+// Prefix adalah middleware yang mencatat prefix route group (misal "/v1") untuk membangun link
+func Prefix(prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(prefixKey, prefix)
+		c.Next()
+	}
+}
+
+// BaseURL adalah scheme://host[/prefix proxy] dari request. Forwarded dan X-Forwarded-* hanya dipakai
+// jika koneksi datang dari proxy tepercaya (SetTrustedProxies) dan scheme hanya boleh http atau https.
+func BaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	host := c.Request.Host
+	if !fromTrustedProxy(c) {
+		return scheme + "://" + host
+	}
+
+	proto, fwdHost := forwarded(c.GetHeader("Forwarded"))
+	if proto == "" && fwdHost == "" {
+		proto = firstValue(c.GetHeader("X-Forwarded-Proto"))
+		fwdHost = firstValue(c.GetHeader("X-Forwarded-Host"))
+	}
+	if proto = strings.ToLower(proto); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+	if fwdHost != "" {
+		host = fwdHost
+	}
+
+	prefix := strings.TrimSuffix(firstValue(c.GetHeader("X-Forwarded-Prefix")), "/")
+	return scheme + "://" + host + prefix
+}
+
+// URL membangun URL absolut untuk path di dalam route group request ini, misal "/character/naruto"
+func URL(c *gin.Context, path string, query url.Values) string {
+	u := BaseURL(c) + c.GetString(prefixKey) + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// PageLinks membuat link self/first/last/prev/next untuk daftar berhalaman.
+// Query request lain (filter, fields, facets) dipertahankan.
+func PageLinks(c *gin.Context, p *Pagination) Links {
+	page := func(n int) Link {
+		query := c.Request.URL.Query()
+		query.Set("page", strconv.Itoa(n))
+		query.Set("limit", strconv.Itoa(p.Limit))
+		return Link{Href: BaseURL(c) + c.Request.URL.Path + "?" + query.Encode()}
+	}
+
+	last := int(p.TotalPages)
+	if last < 1 {
+		last = 1
+	}
+	links := Links{
+		"self":  page(p.Page),
+		"first": page(1),
+		"last":  page(last),
+	}
+	if p.Page > 1 {
+		links["prev"] = page(min(p.Page-1, last))
+	}
+	if p.Page < last {
+		links["next"] = page(p.Page + 1)
+	}
+	return links
+}
+
+// Paginate memotong items untuk halaman page; dipakai untuk hasil yang sudah diurutkan di memori
+func Paginate[T any](items []T, page, limit int) ([]T, *Pagination) {
+	total := int64(len(items))
+	p := &Pagination{
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + int64(limit) - 1) / int64(limit),
+		TotalItems: total,
+	}
+
+	start := (page - 1) * limit
+	if start >= len(items) {
+		return items[:0], p
+	}
+	end := min(start+limit, len(items))
+	return items[start:end], p
+}
+
+// forwarded membaca proto dan host dari elemen pertama header Forwarded (RFC 7239)
+func forwarded(header string) (proto, host string) {
+	first, _, _ := strings.Cut(header, ",")
+	for _, pair := range strings.Split(first, ";") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			continue
+		}
+		v = strings.Trim(v, `"`)
+		switch strings.ToLower(k) {
+		case "proto":
+			proto = v
+		case "host":
+			host = v
+		}
+	}
+	return proto, host
+}
+
+func firstValue(header string) string {
+	v, _, _ := strings.Cut(header, ",")
+	return strings.TrimSpace(v)
+}
+
+// WithLinks menambahkan "_links" (HAL) ke setiap objek di v, yang bisa berupa struct atau slice.
+// Dalam mode kompatibilitas v dikembalikan apa adanya supaya bentuk lama tidak berubah.
+func WithLinks(c *gin.Context, v interface{}, linksFor func(doc map[string]interface{}) Links) (interface{}, error) {
+	if IsLegacy(c) {
+		return v, nil
+	}
+
+	// slice nil akan menjadi null setelah round-trip JSON; daftar kosong tetap harus ditulis sebagai []
+	if isNilSlice(v) {
+		return []interface{}{}, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	switch d := doc.(type) {
+	case []interface{}:
+		for _, item := range d {
+			if m, ok := item.(map[string]interface{}); ok {
+				m["_links"] = linksFor(m)
+			}
+		}
+	case map[string]interface{}:
+		d["_links"] = linksFor(d)
+	}
+	return doc, nil
+}
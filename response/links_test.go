@@ -0,0 +1,74 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type item struct {
+	Slug string `json:"slug"`
+}
+
+func TestWithLinksKeepsEmptyLists(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/suggest?q=-", nil)
+	self := func(doc map[string]interface{}) Links {
+		return Links{"self": {Href: "/" + doc["slug"].(string)}}
+	}
+
+	tests := []struct {
+		name string
+		in   interface{}
+		want string
+	}{
+		{"nil slice", []item(nil), `[]`},
+		{"empty slice", []item{}, `[]`},
+		{"items", []item{{Slug: "naruto"}}, `[{"_links":{"self":{"href":"/naruto"}},"slug":"naruto"}]`},
+		{"single object", item{Slug: "kurama"}, `{"_links":{"self":{"href":"/kurama"}},"slug":"kurama"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WithLinks(c, tt.in, self)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, _ := json.Marshal(got)
+			if string(b) != tt.want {
+				t.Errorf("WithLinks = %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
+
+func TestListWritesEmptyData(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, legacy := range []bool{false, true} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/character?page=2&limit=10", nil)
+		c.Set(legacyKey, legacy)
+
+		linked, err := WithLinks(c, []item(nil), func(map[string]interface{}) Links { return nil })
+		if err != nil {
+			t.Fatal(err)
+		}
+		List(c, linked, &Meta{Pagination: &Pagination{Page: 2, Limit: 10}})
+
+		key := "data"
+		if legacy {
+			key = "result"
+		}
+		var body map[string]json.RawMessage
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		if got := string(body[key]); got != "[]" {
+			t.Errorf("legacy=%v: %q = %s, want [] in %s", legacy, key, got, w.Body.String())
+		}
+	}
+}
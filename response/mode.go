@@ -0,0 +1,21 @@
+package response
+
+import "github.com/gin-gonic/gin"
+
+const legacyKey = "response.legacy"
+
+// LegacyShapes memaksa semua respons memakai bentuk lama, diatur lewat flag -legacy-responses
+var LegacyShapes bool
+
+// UseLegacy adalah middleware untuk route group yang selalu memakai bentuk lama, misal alias tanpa prefix versi
+func UseLegacy() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(legacyKey, true)
+		c.Next()
+	}
+}
+
+// IsLegacy melaporkan apakah respons request ini memakai bentuk lama
+func IsLegacy(c *gin.Context) bool {
+	return LegacyShapes || c.GetBool(legacyKey)
+}
@@ -0,0 +1,53 @@
+package response
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// trustedProxies adalah jaringan yang header Forwarded/X-Forwarded-*-nya dipakai untuk link; kosong berarti tidak ada
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies mengatur IP/CIDR proxy yang boleh menentukan scheme, host dan prefix link,
+// sama dengan daftar yang diberikan ke gin.Engine.SetTrustedProxies
+func SetTrustedProxies(proxies []string) error {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, p := range proxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %q", p)
+		}
+		nets = append(nets, n)
+	}
+	trustedProxies = nets
+	return nil
+}
+
+// fromTrustedProxy melaporkan apakah koneksi langsung request berasal dari proxy tepercaya
+func fromTrustedProxy(c *gin.Context) bool {
+	ip := net.ParseIP(c.RemoteIP())
+	if ip == nil {
+		return false
+	}
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
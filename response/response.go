@@ -0,0 +1,192 @@
+// Package response mendefinisikan envelope respons yang dipakai semua handler:
+// {"data": ..., "meta": {...}, "links": {...}, "errors": [...]}.
+// Mode kompatibilitas menulis bentuk lama ({"message", "result", "error", ...}) untuk klien yang belum migrasi.
+package response
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/models"
+)
+
+// Envelope adalah bentuk semua respons JSON
+type Envelope struct {
+	Data   interface{} `json:"data,omitempty"`
+	Meta   *Meta       `json:"meta,omitempty"`
+	Links  Links       `json:"links,omitempty"`
+	Errors []Error     `json:"errors,omitempty"`
+}
+
+// Meta berisi informasi tambahan di luar data
+type Meta struct {
+	Message    string        `json:"message,omitempty"`
+	Pagination *Pagination   `json:"pagination,omitempty"`
+	Facets     models.Facets `json:"facets,omitempty"`
+	DidYouMean string        `json:"didYouMean,omitempty"`
+	Cached     *bool         `json:"cached,omitempty"`
+	Healthy    *bool         `json:"healthy,omitempty"`
+
+	// Legacy berisi field yang hanya ditulis dalam mode kompatibilitas; nilai nil menghapus field
+	Legacy gin.H `json:"-"`
+}
+
+// Pagination ada jika daftar diminta dengan page dan limit
+type Pagination struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	TotalPages int64 `json:"totalPages"`
+	TotalItems int64 `json:"totalItems"`
+}
+
+// Link adalah satu link hypermedia
+type Link struct {
+	Href string `json:"href"`
+}
+
+// Links dikelompokkan berdasarkan relasi, misal self atau next
+type Links map[string]Link
+
+// Error adalah satu error di envelope
+type Error struct {
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	// Source menunjuk bagian yang gagal, misal resource di pencarian gabungan
+	Source string `json:"source,omitempty"`
+}
+
+func NewError(status int, detail string) Error {
+	return Error{
+		Status: status,
+		Title:  statusText(status),
+		Detail: detail,
+	}
+}
+
+// Bool dipakai untuk field opsional seperti Meta.Cached
+func Bool(b bool) *bool {
+	return &b
+}
+
+// OK menulis respons sukses berisi data; slice nil ditulis sebagai [] supaya "data" selalu ada
+func OK(c *gin.Context, status int, data interface{}, meta *Meta) {
+	if isNilSlice(data) {
+		data = []interface{}{}
+	}
+	Write(c, status, Envelope{Data: data, Meta: meta})
+}
+
+// Fail menulis respons error dengan satu Error
+func Fail(c *gin.Context, status int, detail string) {
+	Write(c, status, Envelope{Errors: []Error{NewError(status, detail)}})
+}
+
+// Write menulis envelope sesuai mode respons request. 204 selalu dikirim tanpa body.
+// Error 5xx pada request yang deadline-nya habis atau dibatalkan client ditulis sebagai 504/499.
+func Write(c *gin.Context, status int, env Envelope) {
+	if status == http.StatusNoContent {
+		c.Status(status)
+		return
+	}
+	// error dicatat di c.Errors supaya access log menuliskannya di server, termasuk detail asli sebelum diganti 504/499
+	for _, e := range env.Errors {
+		_ = c.Error(errors.New(errorMessage(e)))
+	}
+	if status >= http.StatusInternalServerError {
+		if ctxStatus, detail, ok := ContextStatus(c.Request.Context()); ok {
+			status = ctxStatus
+			env = Envelope{Errors: []Error{NewError(status, detail)}}
+		}
+	}
+	if IsLegacy(c) {
+		c.JSON(status, legacyBody(env))
+		return
+	}
+	c.JSON(status, env)
+}
+
+func errorMessage(e Error) string {
+	if e.Source != "" {
+		return e.Source + ": " + e.Detail
+	}
+	return e.Detail
+}
+
+// legacyBody membentuk ulang envelope menjadi bentuk respons sebelum envelope diperkenalkan
+func legacyBody(env Envelope) gin.H {
+	body := gin.H{}
+	if len(env.Errors) > 0 {
+		body["error"] = env.Errors[0].Detail
+	}
+	if env.Data != nil {
+		body["result"] = env.Data
+	}
+	if env.Meta == nil {
+		return body
+	}
+
+	m := env.Meta
+	if m.Message != "" {
+		body["message"] = m.Message
+	}
+	if p := m.Pagination; p != nil {
+		body["page"] = p.Page
+		body["limit"] = p.Limit
+		body["totalPages"] = p.TotalPages
+		body["totalItems"] = p.TotalItems
+	}
+	if m.Facets != nil {
+		body["facets"] = m.Facets
+	}
+	if m.DidYouMean != "" {
+		body["didYouMean"] = m.DidYouMean
+	}
+	if m.Cached != nil {
+		body["cached"] = *m.Cached
+	}
+	if m.Healthy != nil {
+		body["healthy"] = *m.Healthy
+	}
+	for k, v := range m.Legacy {
+		if v == nil {
+			delete(body, k)
+		} else {
+			body[k] = v
+		}
+	}
+	return body
+}
+
+// NoMatch menulis 404 untuk pencarian tanpa hasil, opsional dengan saran ejaan.
+// Bentuk lamanya memakai "message", bukan "error".
+func NoMatch(c *gin.Context, detail, didYouMean string) {
+	Write(c, http.StatusNotFound, Envelope{
+		Meta: &Meta{
+			DidYouMean: didYouMean,
+			Legacy:     gin.H{"error": nil, "message": detail},
+		},
+		Errors: []Error{NewError(http.StatusNotFound, detail)},
+	})
+}
+
+// List menulis daftar; jika meta berisi pagination, link first/last/prev/next ikut ditulis.
+// Daftar kosong (nil atau slice nil) selalu ditulis sebagai [], bukan tanpa "data" atau "result": null.
+func List(c *gin.Context, data interface{}, meta *Meta) {
+	if data == nil || isNilSlice(data) {
+		data = []interface{}{}
+	}
+	env := Envelope{Data: data, Meta: meta}
+	if meta != nil && meta.Pagination != nil {
+		env.Links = PageLinks(c, meta.Pagination)
+	}
+	Write(c, http.StatusOK, env)
+}
+
+func isNilSlice(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Slice && rv.IsNil()
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/admin"
+	"my-gin-app/character"
+	"my-gin-app/gql"
+	"my-gin-app/health"
+	"my-gin-app/metrics"
+	"my-gin-app/middleware"
+	"my-gin-app/openapi"
+	"my-gin-app/ratelimit"
+	"my-gin-app/response"
+	"my-gin-app/search"
+	"my-gin-app/stats"
+	"my-gin-app/suggest"
+	"my-gin-app/tailedbeast"
+)
+
+// Path tanpa prefix versi adalah alias /v1 yang sudah usang
+var (
+	legacyDeprecatedAt = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	legacySunset       = time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC)
+)
+
+// handlers berisi semua handler yang dipasang di router; semua versi API memakai service yang sama
+type handlers struct {
+	character   *character.Handler
+	tailedBeast *tailedbeast.Handler
+	stats       *stats.Handler
+	search      *search.Handler
+	suggest     *suggest.Handler
+	admin       *admin.Handler
+	graphql     *gql.Handler
+	health      *health.Handler
+}
+
+// routeOptions berisi middleware per route yang diatur lewat config
+type routeOptions struct {
+	timeouts middleware.Timeouts
+	// limiter nil berarti tanpa rate limit
+	limiter *ratelimit.Limiter
+	// cors nil berarti tanpa header CORS dan tanpa route preflight
+	cors gin.HandlerFunc
+}
+
+// route adalah satu endpoint relatif terhadap prefix versinya
+type route struct {
+	method  string
+	path    string
+	handler gin.HandlerFunc
+}
+
+func v1Routes(h handlers) []route {
+	return []route{
+		{http.MethodGet, "/character", h.character.IndexUser},
+		{http.MethodGet, "/character/search", h.character.SearchCharacter},
+		{http.MethodPost, "/character", h.character.CreateUser},
+		{http.MethodGet, "/character/:slug", h.character.ReadUser},
+		{http.MethodPut, "/character/:slug", h.character.UpdateUser},
+		{http.MethodDelete, "/character/:slug", h.character.DeleteUser},
+
+		{http.MethodGet, "/tailedbeast", h.tailedBeast.IndexTailedBeast},
+		{http.MethodGet, "/tailedbeast/search", h.tailedBeast.SearchTailedBeast},
+		{http.MethodPost, "/tailedbeast", h.tailedBeast.CreateTailedBeast},
+		{http.MethodGet, "/tailedbeast/:slug", h.tailedBeast.ReadTailedBeast},
+		{http.MethodPut, "/tailedbeast/:slug", h.tailedBeast.UpdateTailedBeast},
+		{http.MethodDelete, "/tailedbeast/:slug", h.tailedBeast.DeleteTailedBeast},
+
+		{http.MethodGet, "/stats/characters", h.stats.CharacterStats},
+		{http.MethodGet, "/stats/tailedbeasts", h.stats.TailedBeastStats},
+
+		{http.MethodGet, "/search", h.search.Search},
+		{http.MethodGet, "/suggest", h.suggest.Suggest},
+
+		{http.MethodGet, "/admin/indexes", h.admin.ListIndexes},
+	}
+}
+
+// v2Overrides berisi endpoint /v2 yang berbeda dari /v1; endpoint lain di /v2 memakai handler /v1.
+// /v2 baru dipasang setelah ada override. Setiap override wajib punya pasangan di v2Operations.
+func v2Overrides(h handlers) []route {
+	return nil
+}
+
+func v1Operations() []openapi.Operation {
+	var ops []openapi.Operation
+	ops = append(ops, character.Operations()...)
+	ops = append(ops, tailedbeast.Operations()...)
+	ops = append(ops, stats.Operations()...)
+	ops = append(ops, search.Operations()...)
+	ops = append(ops, suggest.Operations()...)
+	ops = append(ops, admin.Operations()...)
+	return ops
+}
+
+// v2Operations mendokumentasikan v2Overrides
+func v2Operations() []openapi.Operation {
+	return nil
+}
+
+// operations adalah dokumentasi OpenAPI untuk setiap route di registerRoutes
+func operations() []openapi.Operation {
+	v1 := v1Operations()
+
+	ops := openapi.Prefix("/v1", v1)
+	if overrides := v2Operations(); len(overrides) > 0 {
+		ops = append(ops, openapi.Prefix("/v2", overrideOperations(v1, overrides))...)
+	}
+	ops = append(ops, openapi.Deprecate(v1)...)
+	ops = append(ops, gql.Operations()...)
+	ops = append(ops, health.Operations()...)
+	ops = append(ops, metrics.Operations()...)
+	ops = append(ops, openapi.Operations()...)
+	return ops
+}
+
+// registerRoutes memasang /v1, /v2 (jika ada override) dan alias lama tanpa prefix yang tetap memakai bentuk respons lama.
+// Deadline dan class rate limit setiap route diambil berdasarkan path tanpa prefix versi.
+// Setiap route baru wajib ditambahkan juga ke operations.
+func registerRoutes(router *gin.Engine, h handlers, opts routeOptions) {
+	v1 := v1Routes(h)
+	mount(router.Group("/v1", response.Prefix("/v1")), v1, opts)
+	if overrides := v2Overrides(h); len(overrides) > 0 {
+		mount(router.Group("/v2", response.Prefix("/v2")), overrideRoutes(v1, overrides), opts)
+	}
+	mount(router.Group("", middleware.Deprecated(legacyDeprecatedAt, legacySunset, "/v1"), response.UseLegacy()), v1, opts)
+
+	// GraphQL punya evolusi schema sendiri sehingga tidak ikut prefix versi
+	mount(router.Group(""), []route{
+		{http.MethodGet, "/graphql", h.graphql.Query},
+		{http.MethodPost, "/graphql", h.graphql.Execute},
+	}, opts)
+
+	// probe healthcheck dan metrik tidak berversi supaya konfigurasi Docker, orchestrator dan Prometheus tidak ikut berubah,
+	// dan tidak dibatasi rate limit supaya probe tidak gagal saat API sedang ramai
+	mount(router.Group(""), []route{
+		{http.MethodGet, "/healthz", h.health.Live},
+		{http.MethodGet, "/readyz", h.health.Ready},
+		{http.MethodGet, "/metrics", metrics.Handler()},
+	}, routeOptions{timeouts: opts.timeouts, cors: opts.cors})
+
+	docs := openapi.NewHandler(openapi.Info{Title: "Naruto API", Version: "1.0.0"}, router.Routes, operations())
+	mount(router.Group(""), []route{
+		{http.MethodGet, "/openapi.json", docs.Spec},
+		{http.MethodGet, "/docs", docs.Docs},
+		{http.MethodGet, "/docs/assets/:file", docs.Asset},
+	}, routeOptions{cors: opts.cors})
+}
+
+// mount memasang routes di group. CORS dipasang paling depan supaya respons 429 dan 504 tetap bisa dibaca browser,
+// dan setiap path mendapat route OPTIONS untuk preflight.
+func mount(group *gin.RouterGroup, routes []route, opts routeOptions) {
+	preflight := map[string]bool{}
+	for _, r := range routes {
+		var chain []gin.HandlerFunc
+		if opts.cors != nil {
+			chain = append(chain, opts.cors)
+			if !preflight[r.path] {
+				preflight[r.path] = true
+				group.OPTIONS(r.path, opts.cors, noContent)
+			}
+		}
+		chain = append(chain, middleware.Timeout(opts.timeouts.For(r.method, r.path)))
+		if opts.limiter != nil {
+			chain = append(chain, opts.limiter.Handler(rateClass(r)))
+		}
+		group.Handle(r.method, r.path, append(chain, r.handler)...)
+	}
+}
+
+// noContent menjawab OPTIONS yang bukan preflight CORS
+func noContent(c *gin.Context) {
+	c.Status(http.StatusNoContent)
+}
+
+// rateClass memilih kuota route: list tanpa halaman dihitung sebagai export, GET lain read, selebihnya write.
+// GraphQL dihitung read untuk GET maupun POST karena client umumnya mengirim query lewat POST.
+func rateClass(r route) ratelimit.ClassFunc {
+	switch {
+	case r.path == "/graphql":
+		return ratelimit.Fixed(ratelimit.Read)
+	case r.method != http.MethodGet:
+		return ratelimit.Fixed(ratelimit.Write)
+	case r.path == "/character" || r.path == "/tailedbeast":
+		return ratelimit.Unpaginated
+	default:
+		return ratelimit.Fixed(ratelimit.Read)
+	}
+}
+
+// overrideRoutes mengganti route base yang method dan path-nya sama dengan overrides
+func overrideRoutes(base, overrides []route) []route {
+	replaced := map[string]route{}
+	for _, r := range overrides {
+		replaced[r.method+" "+r.path] = r
+	}
+
+	out := make([]route, 0, len(base)+len(overrides))
+	for _, r := range base {
+		key := r.method + " " + r.path
+		if o, ok := replaced[key]; ok {
+			r = o
+			delete(replaced, key)
+		}
+		out = append(out, r)
+	}
+	// endpoint yang hanya ada di /v2
+	for _, r := range overrides {
+		if _, ok := replaced[r.method+" "+r.path]; ok {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// overrideOperations adalah pasangan overrideRoutes untuk dokumentasi
+func overrideOperations(base, overrides []openapi.Operation) []openapi.Operation {
+	replaced := map[string]openapi.Operation{}
+	for _, op := range overrides {
+		replaced[op.Method+" "+op.Path] = op
+	}
+
+	out := make([]openapi.Operation, 0, len(base)+len(overrides))
+	for _, op := range base {
+		key := op.Method + " " + op.Path
+		if o, ok := replaced[key]; ok {
+			op = o
+			delete(replaced, key)
+		}
+		out = append(out, op)
+	}
+	for _, op := range overrides {
+		if _, ok := replaced[op.Method+" "+op.Path]; ok {
+			out = append(out, op)
+		}
+	}
+	return out
+}
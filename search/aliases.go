@@ -0,0 +1,34 @@
+package search
+
+// aliases memetakan sebutan lain (nama jepang per jumlah ekor, ejaan lama) ke nama kanonik.
+// Kunci sudah dalam bentuk Normalize.
+var aliases = map[string]string{}
+
+func init() {
+	for alias, name := range map[string]string{
+		"Ichibi":              "Shukaku",
+		"One Tails":           "Shukaku",
+		"Nibi":                "Matatabi",
+		"Two Tails":           "Matatabi",
+		"Sanbi":               "Isobu",
+		"Three Tails":         "Isobu",
+		"Yonbi":               "Son Goku",
+		"Four Tails":          "Son Goku",
+		"Gobi":                "Kokuo",
+		"Five Tails":          "Kokuo",
+		"Rokubi":              "Saiken",
+		"Six Tails":           "Saiken",
+		"Nanabi":              "Chomei",
+		"Seven Tails":         "Chomei",
+		"Hachibi":             "Gyuki",
+		"Eight Tails":         "Gyuki",
+		"Kyuubi":              "Kurama",
+		"Nine Tails":          "Kurama",
+		"Kyubi no Kitsune":    "Kurama",
+		"Bee":                 "Killer B",
+		"Killer Bee":          "Killer B",
+		"Gaara of the Desert": "Gaara",
+	} {
+		aliases[Normalize(alias)] = name
+	}
+}
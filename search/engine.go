@@ -0,0 +1,173 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// resourceTimeout membatasi waktu tunggu satu resource supaya resource yang lambat tidak menahan seluruh respons
+const resourceTimeout = 3 * time.Second
+
+// Result adalah satu hasil pencarian gabungan; Type membedakan asal resource
+// dan Score sudah dinormalisasi ke rentang 0..1 supaya bisa dibandingkan antar resource.
+type Result struct {
+	Type  string      `json:"type"`
+	Slug  string      `json:"slug"`
+	Name  string      `json:"name"`
+	Score float64     `json:"score"`
+	Data  interface{} `json:"data"`
+}
+
+// Resource adalah sumber data yang bisa ikut dalam pencarian gabungan
+type Resource interface {
+	Type() string
+	Search(ctx context.Context, q string, limit int) ([]Result, error)
+}
+
+// ResourceError mencatat resource yang gagal tanpa menggagalkan seluruh pencarian
+type ResourceError struct {
+	Type  string `json:"type"`
+	Error string `json:"error"`
+}
+
+var ErrAllResourcesFailed = errors.New("all search resources failed")
+
+// Engine menjalankan pencarian ke semua resource terdaftar secara paralel
+type Engine struct {
+	resources []Resource
+}
+
+func NewEngine(resources ...Resource) *Engine {
+	return &Engine{
+		resources: resources,
+	}
+}
+
+// Types mengembalikan type semua resource terdaftar
+func (e *Engine) Types() []string {
+	types := make([]string, len(e.resources))
+	for i, r := range e.resources {
+		types[i] = r.Type()
+	}
+	return types
+}
+
+// Search menjalankan q ke resource yang diminta (semua jika types kosong) dengan limit per type,
+// lalu menggabungkan hasil berdasarkan skor. Resource yang error atau timeout dilaporkan di errs.
+// Setiap resource mendapat deadline sendiri yang tidak melebihi deadline ctx.
+func (e *Engine) Search(ctx context.Context, q string, types []string, limits map[string]int) (results []Result, errs []ResourceError, err error) {
+	selected := e.resources
+	if len(types) > 0 {
+		selected = nil
+		for _, r := range e.resources {
+			for _, t := range types {
+				if r.Type() == t {
+					selected = append(selected, r)
+				}
+			}
+		}
+	}
+
+	type outcome struct {
+		typ     string
+		results []Result
+		err     error
+	}
+
+	outcomes := make(chan outcome, len(selected))
+	var wg sync.WaitGroup
+	for _, r := range selected {
+		wg.Add(1)
+		go func(r Resource) {
+			defer wg.Done()
+
+			rctx, cancel := context.WithTimeout(ctx, resourceTimeout)
+			defer cancel()
+
+			done := make(chan outcome, 1)
+			go func() {
+				res, err := r.Search(rctx, q, limits[r.Type()])
+				done <- outcome{typ: r.Type(), results: res, err: err}
+			}()
+
+			select {
+			case o := <-done:
+				outcomes <- o
+			case <-rctx.Done():
+				err := rctx.Err()
+				if ctx.Err() == nil {
+					err = fmt.Errorf("timed out after %s", resourceTimeout)
+				}
+				outcomes <- outcome{typ: r.Type(), err: err}
+			}
+		}(r)
+	}
+	wg.Wait()
+	close(outcomes)
+
+	for o := range outcomes {
+		if o.err != nil {
+			errs = append(errs, ResourceError{Type: o.typ, Error: o.err.Error()})
+			continue
+		}
+		results = append(results, o.results...)
+	}
+
+	if len(selected) > 0 && len(errs) == len(selected) {
+		return nil, errs, ErrAllResourcesFailed
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Type < errs[j].Type })
+
+	return results, errs, nil
+}
+
+// Merge menggabungkan hasil full-text (skor dinormalisasi dengan NormalizeScores) dengan hasil
+// pencarian nama fuzzy; slug yang sama diambil skor tertingginya. Hasil dipotong sampai limit.
+func Merge(limit int, groups ...[]Result) []Result {
+	bySlug := map[string]int{}
+	var merged []Result
+	for _, group := range groups {
+		for _, r := range group {
+			if i, ok := bySlug[r.Slug]; ok {
+				if r.Score > merged[i].Score {
+					merged[i] = r
+				}
+				continue
+			}
+			bySlug[r.Slug] = len(merged)
+			merged = append(merged, r)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Score > merged[j].Score })
+	if limit > 0 && len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
+}
+
+// textScoreHalf adalah skor text MongoDB yang dipetakan ke 0.5 oleh NormalizeScores
+const textScoreHalf = 1.0
+
+// NormalizeScores memetakan skor text MongoDB ke 0..1 dengan s/(s+k). Skalanya absolut dan sama untuk
+// setiap resource, jadi hasil teratas resource yang hanya cocok lemah tidak ikut bernilai 1.
+func NormalizeScores(results []Result) {
+	for i := range results {
+		if s := results[i].Score; s > 0 {
+			results[i].Score = s / (s + textScoreHalf)
+		} else {
+			results[i].Score = 0
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package search
+
+import "testing"
+
+func TestNormalizeScoresIsAbsolute(t *testing.T) {
+	// resource yang hanya cocok lemah tidak boleh ikut mendapat skor 1
+	strong := []Result{{Slug: "naruto-uzumaki", Score: 3}, {Slug: "sasuke-uchiha", Score: 1}}
+	weak := []Result{{Slug: "kurama", Score: 1}, {Slug: "shukaku", Score: 0}}
+	NormalizeScores(strong)
+	NormalizeScores(weak)
+
+	want := map[string]float64{"naruto-uzumaki": 0.75, "sasuke-uchiha": 0.5, "kurama": 0.5, "shukaku": 0}
+	for _, r := range append(strong, weak...) {
+		if r.Score != want[r.Slug] {
+			t.Errorf("%s score = %v, want %v", r.Slug, r.Score, want[r.Slug])
+		}
+	}
+}
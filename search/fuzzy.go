@@ -0,0 +1,232 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gosimple/unidecode"
+)
+
+// Skor kecocokan nama; semakin tinggi semakin mirip
+const (
+	ScoreExact  = 1.0
+	ScoreAlias  = 0.95
+	ScorePrefix = 0.9
+	ScoreWord   = 0.8
+	ScoreFuzzy  = 0.7
+)
+
+// minSubstring adalah panjang minimal query agar boleh cocok di tengah nama
+const minSubstring = 3
+
+// romanization menyamakan variasi penulisan romaji (Hepburn vs Kunrei, dsb.), urutan penting
+var romanization = strings.NewReplacer(
+	"shi", "si",
+	"chi", "ti",
+	"tsu", "tu",
+	"fu", "hu",
+	"ji", "zi",
+	"sh", "sy",
+	"ch", "ty",
+	"j", "z",
+)
+
+// Match adalah kandidat yang cocok dengan query beserta skornya
+type Match struct {
+	Index int
+	Score float64
+}
+
+// Fold adalah normalisasi ringan yang aman untuk prefix: huruf kecil, tanpa diakritik (Ō → o),
+// tanda baca jadi spasi, huruf ganda (kk, oo, uu) disingkat.
+func Fold(s string) string {
+	s = strings.ToLower(unidecode.Unidecode(s))
+
+	var b strings.Builder
+	var prev rune
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			r = ' '
+		}
+		if r == prev {
+			continue
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Normalize membuat kunci pencarian dari nama: Fold, lalu vokal panjang disingkat dan romanisasi disamakan.
+// Dengan begitu "Ōtsutsuki", "Otsutsuki" dan "Ootsutsuki" menghasilkan kunci yang sama.
+func Normalize(s string) string {
+	words := strings.Fields(Fold(s))
+	for i, w := range words {
+		w = strings.ReplaceAll(w, "ou", "o")
+		w = collapseOh(w)
+		w = strings.ReplaceAll(w, "tch", "ch")
+		words[i] = romanization.Replace(w)
+	}
+	return strings.Join(words, " ")
+}
+
+// collapseOh mengubah "oh" sebelum konsonan atau di akhir kata menjadi "o" (Ohnoki → Onoki)
+func collapseOh(w string) string {
+	var b strings.Builder
+	for i := 0; i < len(w); i++ {
+		if w[i] == 'o' && i+1 < len(w) && w[i+1] == 'h' && (i+2 == len(w) || !isVowel(w[i+2])) {
+			b.WriteByte('o')
+			i++
+			continue
+		}
+		b.WriteByte(w[i])
+	}
+	return b.String()
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
+
+// Score menilai seberapa cocok query dengan sebuah nama, 0 berarti tidak cocok
+func Score(query, name string) float64 {
+	q, n := Normalize(query), Normalize(name)
+	if q == "" || n == "" {
+		return 0
+	}
+
+	switch {
+	case q == n:
+		return ScoreExact
+	case strings.HasPrefix(n, q):
+		return ScorePrefix
+	case len(q) >= minSubstring && strings.Contains(n, q):
+		return ScoreWord
+	}
+
+	// Setiap kata query harus cocok (dengan toleransi typo) dengan salah satu kata nama
+	nameWords := strings.Fields(n)
+	total := 0
+	for _, qw := range strings.Fields(q) {
+		best := -1
+		for _, nw := range nameWords {
+			d := wordDistance(qw, nw)
+			if d >= 0 && (best < 0 || d < best) {
+				best = d
+			}
+		}
+		if best < 0 {
+			return 0
+		}
+		total += best
+	}
+
+	return ScoreFuzzy - 0.05*float64(total)
+}
+
+// Rank mengembalikan kandidat yang cocok dengan query, urut dari skor tertinggi.
+// Alias transliterasi (misal "kyuubi" → "Kurama") dihitung sebagai kecocokan hampir pasti.
+func Rank(query string, names []string) []Match {
+	var matches []Match
+	for i, name := range names {
+		if score := NameScore(query, name); score > 0 {
+			matches = append(matches, Match{Index: i, Score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// NameScore sama dengan Score tetapi juga memperhitungkan alias transliterasi
+func NameScore(query, name string) float64 {
+	score := Score(query, name)
+	if alias := aliases[Normalize(query)]; alias != "" && Normalize(alias) == Normalize(name) && score < ScoreAlias {
+		score = ScoreAlias
+	}
+	return score
+}
+
+// Suggest mencari nama terdekat untuk "did you mean" saat Rank tidak menemukan apa pun.
+// Toleransinya lebih longgar dari Rank: setengah panjang query.
+func Suggest(query string, names []string) string {
+	q := Normalize(query)
+	if q == "" {
+		return ""
+	}
+
+	best, bestDist := "", len(q)/2+1
+	for _, name := range names {
+		n := Normalize(name)
+		d := levenshtein(q, n)
+		for _, w := range strings.Fields(n) {
+			if wd := levenshtein(q, w); wd < d {
+				d = wd
+			}
+		}
+		if d < bestDist {
+			best, bestDist = name, d
+		}
+	}
+	return best
+}
+
+// wordDistance mengembalikan jarak edit antara kata query dan kata nama, atau -1 jika melebihi toleransi.
+// Kata query yang merupakan awalan kata nama dianggap cocok dengan jarak 0.
+func wordDistance(q, n string) int {
+	if strings.HasPrefix(n, q) {
+		return 0
+	}
+	d := levenshtein(q, n)
+	if d > tolerance(q) {
+		return -1
+	}
+	return d
+}
+
+// tolerance: kata pendek harus persis, kata menengah boleh 1 typo, kata panjang 2
+func tolerance(word string) int {
+	switch n := len([]rune(word)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// NoMatchError dikembalikan service saat pencarian nama kosong; DidYouMean berisi saran nama jika ada
+type NoMatchError struct {
+	Message    string
+	DidYouMean string
+}
+
+func (e *NoMatchError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,152 @@
+package search
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+var shinobi = []string{"Itachi Uchiha", "Kakashi Hatake", "Shukaku", "Kaguya Ōtsutsuki", "Naruto Uzumaki", "Kurama", "Onoki"}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Itachi", "itati"},
+		{"Itatchi", "itati"},
+		{"Kakashi", "kakasi"},
+		{"Kakasi", "kakasi"},
+		{"Shukaku", "syukaku"},
+		{"Shukkaku", "syukaku"},
+		{"Ōtsutsuki", "otutuki"},
+		{"Otsutsuki", "otutuki"},
+		{"Ootsutsuki", "otutuki"},
+		{"Ohnoki", "onoki"},
+		{"Kyuubi", "kyubi"},
+		{"  Killer-Bee!  ", "kiler be"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		query, name string
+		want        float64
+	}{
+		{"Itatchi", "Itachi", ScoreExact},
+		{"Kakasi", "Kakashi", ScoreExact},
+		{"Shukkaku", "Shukaku", ScoreExact},
+		{"Otsutsuki", "Ōtsutsuki", ScoreExact},
+		{"Itatchi", "Itachi Uchiha", ScorePrefix},
+		{"otsutsuki", "Kaguya Ōtsutsuki", ScoreWord},
+		{"kakasi hatak", "Kakashi Hatake", ScorePrefix},
+		{"Narutp", "Naruto Uzumaki", ScoreFuzzy - 0.05},
+		{"hatake kakasi", "Kakashi Hatake", ScoreFuzzy},
+		// kata pendek harus persis
+		{"nar", "Kakashi Hatake", 0},
+		{"", "Itachi", 0},
+	}
+	for _, tt := range tests {
+		if got := Score(tt.query, tt.name); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Score(%q, %q) = %v, want %v", tt.query, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNameScoreAliases(t *testing.T) {
+	tests := []struct {
+		query, name string
+		want        float64
+	}{
+		{"Kyuubi", "Kurama", ScoreAlias},
+		{"kyubi", "Kurama", ScoreAlias},
+		{"Ichibi", "Shukaku", ScoreAlias},
+		{"One Tails", "Shukaku", ScoreAlias},
+		{"Killer Bee", "Killer B", ScoreAlias},
+		// alias tidak menurunkan skor yang sudah lebih tinggi
+		{"Shukaku", "Shukaku", ScoreExact},
+		// alias hanya berlaku untuk nama kanoniknya
+		{"Kyuubi", "Shukaku", 0},
+	}
+	for _, tt := range tests {
+		if got := NameScore(tt.query, tt.name); got != tt.want {
+			t.Errorf("NameScore(%q, %q) = %v, want %v", tt.query, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []Match
+	}{
+		{"Itatchi", []Match{{Index: 0, Score: ScorePrefix}}},
+		{"Kakasi", []Match{{Index: 1, Score: ScorePrefix}}},
+		{"Shukkaku", []Match{{Index: 2, Score: ScoreExact}}},
+		{"Otsutsuki", []Match{{Index: 3, Score: ScoreWord}}},
+		{"Kyuubi", []Match{{Index: 5, Score: ScoreAlias}}},
+		{"Ohnoki", []Match{{Index: 6, Score: ScoreExact}}},
+		{"Orochimaru", nil},
+	}
+	for _, tt := range tests {
+		if got := Rank(tt.query, shinobi); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Rank(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestRankOrdersByScore(t *testing.T) {
+	got := Rank("kaka", []string{"Kakuzu", "Kakashi Hatake", "Kaka"})
+	want := []Match{{Index: 2, Score: ScoreExact}, {Index: 1, Score: ScorePrefix}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Rank = %v, want %v", got, want)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		query, want string
+	}{
+		{"Itatchy", "Itachi Uchiha"},
+		{"Kakasy", "Kakashi Hatake"},
+		{"Shukkako", "Shukaku"},
+		{"Otsutsoki", "Kaguya Ōtsutsuki"},
+		{"Kurana", "Kurama"},
+		// terlalu jauh dari semua nama
+		{"Orochimaru", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Suggest(tt.query, shinobi); got != tt.want {
+			t.Errorf("Suggest(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"itatchi", "itachi", 1},
+		{"kakasi", "kakashi", 1},
+		{"shukkaku", "shukaku", 1},
+		{"ōtsutsuki", "otsutsuki", 1},
+		{"kitten", "sitting", 3},
+		{"", "naruto", 6},
+		{"naruto", "naruto", 0},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := levenshtein(tt.b, tt.a); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package search
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/response"
+)
+
+const defaultLimit = 10
+
+type Handler struct {
+	Engine *Engine
+}
+
+func NewHandler(engine *Engine) *Handler {
+	return &Handler{
+		Engine: engine,
+	}
+}
+
+// Search handler untuk mencari di semua resource sekaligus.
+// limit berlaku per type dan bisa di-override per type, misal limit.character=5.
+func (h *Handler) Search(c *gin.Context) {
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" {
+		response.Fail(c, http.StatusBadRequest, "q query parameter is required")
+		return
+	}
+
+	known := h.Engine.Types()
+	var types []string
+	if typesStr := c.Query("types"); typesStr != "" {
+		for _, t := range strings.Split(typesStr, ",") {
+			t = strings.TrimSpace(t)
+			if !contains(known, t) {
+				response.Fail(c, http.StatusBadRequest, "Invalid type "+t)
+				return
+			}
+			types = append(types, t)
+		}
+	}
+
+	limit, ok := parseLimit(c, "limit", defaultLimit)
+	if !ok {
+		return
+	}
+	limits := map[string]int{}
+	for _, t := range known {
+		if limits[t], ok = parseLimit(c, "limit."+t, limit); !ok {
+			return
+		}
+	}
+
+	results, errs, err := h.Engine.Search(c.Request.Context(), q, types, limits)
+	failures := resourceErrors(errs)
+	if err != nil {
+		response.Write(c, http.StatusInternalServerError, response.Envelope{
+			Meta:   &response.Meta{Legacy: gin.H{"error": err.Error(), "errors": errs}},
+			Errors: append([]response.Error{response.NewError(http.StatusInternalServerError, err.Error())}, failures...),
+		})
+		return
+	}
+
+	if len(results) == 0 {
+		meta := &response.Meta{Legacy: gin.H{"error": nil, "message": "No results found"}}
+		if len(errs) > 0 {
+			meta.Legacy["errors"] = errs
+		}
+		response.Write(c, http.StatusNotFound, response.Envelope{
+			Meta:   meta,
+			Errors: append([]response.Error{response.NewError(http.StatusNotFound, "No results found")}, failures...),
+		})
+		return
+	}
+
+	data, err := response.WithLinks(c, results, ItemLinks(c))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	meta := &response.Meta{Message: "Found results"}
+	if len(errs) > 0 {
+		meta.Legacy = gin.H{"errors": errs}
+	}
+	response.Write(c, http.StatusOK, response.Envelope{
+		Data:   data,
+		Meta:   meta,
+		Errors: failures,
+	})
+}
+
+// ItemLinks membuat link self untuk objek yang punya type dan slug; type sama dengan path resource-nya
+func ItemLinks(c *gin.Context) func(doc map[string]interface{}) response.Links {
+	return func(doc map[string]interface{}) response.Links {
+		typ, _ := doc["type"].(string)
+		slug, _ := doc["slug"].(string)
+		return response.Links{"self": {Href: response.URL(c, "/"+typ+"/"+slug, nil)}}
+	}
+}
+
+// resourceErrors mengubah resource yang gagal menjadi error envelope; hasil resource lain tetap dikirim
+func resourceErrors(errs []ResourceError) []response.Error {
+	var out []response.Error
+	for _, e := range errs {
+		re := response.NewError(http.StatusInternalServerError, e.Error)
+		re.Source = e.Type
+		out = append(out, re)
+	}
+	return out
+}
+
+func parseLimit(c *gin.Context, param string, fallback int) (int, bool) {
+	limitStr := c.Query(param)
+	if limitStr == "" {
+		return fallback, true
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < 1 {
+		response.Fail(c, http.StatusBadRequest, "Invalid "+param+" number")
+		return 0, false
+	}
+	return limit, true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
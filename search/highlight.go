@@ -0,0 +1,116 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"my-gin-app/models"
+)
+
+// snippetContext adalah jumlah karakter di kiri/kanan kata yang cocok yang ikut ditampilkan
+const snippetContext = 30
+
+// Terms memecah query menjadi kata-kata lowercase, tanda baca diabaikan
+func Terms(q string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	seen := map[string]bool{}
+	var terms []string
+	for _, f := range fields {
+		if !seen[f] {
+			seen[f] = true
+			terms = append(terms, f)
+		}
+	}
+	return terms
+}
+
+// Highlight mencari kata dari terms di dalam text dan mengembalikan snippet dengan kata yang cocok dibungkus <em>.
+// Term dicocokkan dengan awal kata dan seluruh kata ikut ditandai, jadi "clone" menandai "Clones".
+// Teks dokumen berasal dari input user, jadi semua teks di snippet di-escape HTML dan hanya <em> yang berupa tag.
+func Highlight(field, text string, terms []string) (models.Highlight, bool) {
+	if text == "" || len(terms) == 0 {
+		return models.Highlight{}, false
+	}
+
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+
+	type span struct{ start, end int }
+	var spans []span
+	for i := 0; i < len(lower); i++ {
+		if i > 0 && isWordRune(lower[i-1]) {
+			continue
+		}
+		for _, term := range terms {
+			t := []rune(stem(term))
+			if len(t) == 0 || i+len(t) > len(lower) || string(lower[i:i+len(t)]) != string(t) {
+				continue
+			}
+			end := i + len(t)
+			for end < len(lower) && isWordRune(lower[end]) {
+				end++
+			}
+			spans = append(spans, span{i, end})
+			i = end - 1
+			break
+		}
+	}
+	if len(spans) == 0 {
+		return models.Highlight{}, false
+	}
+
+	from := spans[0].start - snippetContext
+	if from < 0 {
+		from = 0
+	}
+	to := spans[len(spans)-1].end + snippetContext
+	if to > len(runes) {
+		to = len(runes)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, s := range spans {
+		b.WriteString(html.EscapeString(string(runes[pos:s.start])))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(string(runes[s.start:s.end])))
+		b.WriteString("</em>")
+		pos = s.end
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:to])))
+	if to < len(runes) {
+		b.WriteString("…")
+	}
+
+	return models.Highlight{Field: field, Snippet: b.String()}, true
+}
+
+// HighlightAll menjalankan Highlight untuk setiap nilai field (misal daftar jutsu)
+func HighlightAll(field string, values []string, terms []string) []models.Highlight {
+	var highlights []models.Highlight
+	for _, v := range values {
+		if h, ok := Highlight(field, v, terms); ok {
+			highlights = append(highlights, h)
+		}
+	}
+	return highlights
+}
+
+// stem membuang akhiran jamak sederhana supaya mirip dengan stemming text index MongoDB
+func stem(term string) string {
+	if len(term) > 3 && strings.HasSuffix(term, "s") {
+		return strings.TrimSuffix(term, "s")
+	}
+	return term
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
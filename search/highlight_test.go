@@ -0,0 +1,19 @@
+package search
+
+import "testing"
+
+func TestHighlightEscapesDocumentText(t *testing.T) {
+	h, ok := Highlight("name", `<img src=x onerror=alert(1)> Naruto<script>`, Terms("naruto"))
+	if !ok {
+		t.Fatal("no highlight")
+	}
+	want := `&lt;img src=x onerror=alert(1)&gt; <em>Naruto</em>&lt;script&gt;`
+	if h.Snippet != want {
+		t.Errorf("Snippet = %q, want %q", h.Snippet, want)
+	}
+
+	h, _ = Highlight("jutsu", `Rasengan"><b>`, Terms("rasengan"))
+	if want := `<em>Rasengan</em>&#34;&gt;&lt;b&gt;`; h.Snippet != want {
+		t.Errorf("Snippet = %q, want %q", h.Snippet, want)
+	}
+}
@@ -0,0 +1,24 @@
+package search
+
+import (
+	"net/http"
+
+	"my-gin-app/openapi"
+)
+
+// Operations mendokumentasikan route pencarian gabungan untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/search", Tag: "search",
+			Summary: "Search every resource at once",
+			Query: []openapi.Param{
+				{Name: "q", Required: true, Description: "Search query"},
+				{Name: "types", Description: "Comma separated resource types to search"},
+				{Name: "limit", Type: "integer", Description: "Results per type (default 10), override with limit.<type>"},
+			},
+			Result: []Result{},
+			Linked: true,
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package stats
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-gin-app/cache"
+	"my-gin-app/character"
+	"my-gin-app/models"
+	"my-gin-app/response"
+	"my-gin-app/tailedbeast"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultTop = 10
+	maxTop     = 100
+)
+
+type Handler struct {
+	Characters   character.Service
+	TailedBeasts tailedbeast.Service
+
+	characterCache *cache.Cache[*models.CharacterStats]
+	beastCache     *cache.Cache[*models.TailedBeastStats]
+}
+
+// NewHandler membuat handler statistik; hasil aggregation di-cache selama ttl
+func NewHandler(characters character.Service, tailedBeasts tailedbeast.Service, ttl time.Duration) *Handler {
+	return &Handler{
+		Characters:     characters,
+		TailedBeasts:   tailedBeasts,
+		characterCache: cache.New[*models.CharacterStats](ttl),
+		beastCache:     cache.New[*models.TailedBeastStats](ttl),
+	}
+}
+
+// Caches mengembalikan cache statistik per nama untuk metrik hit/miss
+func (h *Handler) Caches() map[string]cache.StatsReporter {
+	return map[string]cache.StatsReporter{
+		"stats.characters":   h.characterCache,
+		"stats.tailedbeasts": h.beastCache,
+	}
+}
+
+// CharacterStats handler untuk statistik karakter, opsional ?groupBy=clan&top=10
+func (h *Handler) CharacterStats(c *gin.Context) {
+	groupBy := c.Query("groupBy")
+	top := defaultTop
+	if topStr := c.Query("top"); topStr != "" {
+		var err error
+		top, err = strconv.Atoi(topStr)
+		if err != nil || top < 1 || top > maxTop {
+			response.Fail(c, http.StatusBadRequest, "Invalid top number")
+			return
+		}
+	}
+
+	key := groupBy + "|" + strconv.Itoa(top)
+	result, cached := h.characterCache.Get(key)
+	if !cached {
+		var err error
+		result, err = h.Characters.CharacterStats(c.Request.Context(), top, groupBy)
+		if err != nil {
+			statsError(c, err)
+			return
+		}
+		h.characterCache.Set(key, result)
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{
+		Message: "Success retrieved statistics",
+		Cached:  response.Bool(cached),
+	})
+}
+
+// TailedBeastStats handler untuk statistik tailed beast, opsional ?groupBy=rank
+func (h *Handler) TailedBeastStats(c *gin.Context) {
+	groupBy := c.Query("groupBy")
+
+	result, cached := h.beastCache.Get(groupBy)
+	if !cached {
+		var err error
+		result, err = h.TailedBeasts.BeastStats(c.Request.Context(), groupBy)
+		if err != nil {
+			statsError(c, err)
+			return
+		}
+		h.beastCache.Set(groupBy, result)
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{
+		Message: "Success retrieved statistics",
+		Cached:  response.Bool(cached),
+	})
+}
+
+func statsError(c *gin.Context, err error) {
+	if strings.HasPrefix(err.Error(), "invalid groupBy") {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Fail(c, http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,31 @@
+package stats
+
+import (
+	"net/http"
+
+	"my-gin-app/models"
+	"my-gin-app/openapi"
+)
+
+// Operations mendokumentasikan route statistik untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/stats/characters", Tag: "stats",
+			Summary: "Character statistics, cached per groupBy and top",
+			Query: []openapi.Param{
+				{Name: "groupBy", Description: "Group statistics by a facet name, e.g. clan or rank.ninjaRank"},
+				{Name: "top", Type: "integer", Description: "Number of top entries, 1-100 (default 10)"},
+			},
+			Result: models.CharacterStats{},
+		},
+		{
+			Method: http.MethodGet, Path: "/stats/tailedbeasts", Tag: "stats",
+			Summary: "Tailed beast statistics, cached per groupBy",
+			Query: []openapi.Param{
+				{Name: "groupBy", Description: "Group statistics by rank"},
+			},
+			Result: models.TailedBeastStats{},
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package suggest
+
+import (
+	"context"
+
+	"github.com/gosimple/slug"
+
+	"my-gin-app/character"
+	"my-gin-app/models"
+)
+
+// characterService membungkus character.Service supaya index ikut diperbarui di setiap Create/Update/Delete
+type characterService struct {
+	character.Service
+	index *Index
+}
+
+// CharacterService mengembalikan character.Service yang menjaga index tetap sinkron
+func CharacterService(inner character.Service, index *Index) character.Service {
+	return &characterService{
+		Service: inner,
+		index:   index,
+	}
+}
+
+// LoadCharacters mengisi index dengan semua karakter yang ada
+func LoadCharacters(ctx context.Context, index *Index, service character.Service) error {
+	characters, _, err := service.ListCharacters(ctx, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]Entry, len(characters))
+	for i, c := range characters {
+		entries[i] = characterEntry(&c)
+	}
+	index.Replace(TypeCharacter, entries)
+	return nil
+}
+
+func characterEntry(c *models.Character) Entry {
+	e := Entry{Slug: c.Slug, Name: c.Name, Type: TypeCharacter}
+	if len(c.Images) > 0 {
+		e.Image = c.Images[0]
+	}
+	return e
+}
+
+func (s *characterService) CreateCharacter(ctx context.Context, c *models.Character) error {
+	if err := s.Service.CreateCharacter(ctx, c); err != nil {
+		return err
+	}
+	s.index.Put(characterEntry(c))
+	return nil
+}
+
+func (s *characterService) GetCharacterBySlug(ctx context.Context, slugParam string, fields ...string) (*models.Character, error) {
+	c, err := s.Service.GetCharacterBySlug(ctx, slugParam, fields...)
+	if err == nil {
+		s.index.Hit(TypeCharacter, c.Slug)
+	}
+	return c, err
+}
+
+func (s *characterService) UpdateCharacter(ctx context.Context, slugParam string, updatedData *models.Character) error {
+	if err := s.Service.UpdateCharacter(ctx, slugParam, updatedData); err != nil {
+		return err
+	}
+
+	newSlug := slugParam
+	if updatedData.Name != "" {
+		newSlug = slug.Make(updatedData.Name)
+	}
+	updated, err := s.Service.GetCharacterBySlug(ctx, newSlug)
+	if err != nil {
+		// update sudah tersimpan; index cukup sinkron lagi saat reload berikutnya
+		return nil
+	}
+	if newSlug != slugParam {
+		s.index.Remove(TypeCharacter, slugParam)
+	}
+	s.index.Put(characterEntry(updated))
+	return nil
+}
+
+func (s *characterService) DeleteCharacter(ctx context.Context, slugParam string) error {
+	if err := s.Service.DeleteCharacter(ctx, slugParam); err != nil {
+		return err
+	}
+	s.index.Remove(TypeCharacter, slugParam)
+	return nil
+}
+
+func (s *characterService) ReslugCharacters(ctx context.Context) (int, error) {
+	n, err := s.Service.ReslugCharacters(ctx)
+	if n > 0 {
+		if loadErr := LoadCharacters(ctx, s.index, s.Service); err == nil {
+			err = loadErr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,66 @@
+package suggest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/response"
+	"my-gin-app/search"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 50
+)
+
+type Handler struct {
+	Index *Index
+}
+
+func NewHandler(index *Index) *Handler {
+	return &Handler{
+		Index: index,
+	}
+}
+
+// Suggest handler untuk autocomplete nama berdasarkan prefix
+func (h *Handler) Suggest(c *gin.Context) {
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" {
+		response.Fail(c, http.StatusBadRequest, "q query parameter is required")
+		return
+	}
+
+	var types []string
+	if typesStr := c.Query("types"); typesStr != "" {
+		for _, t := range strings.Split(typesStr, ",") {
+			t = strings.TrimSpace(t)
+			if t != TypeCharacter && t != TypeTailedBeast {
+				response.Fail(c, http.StatusBadRequest, "Invalid type "+t)
+				return
+			}
+			types = append(types, t)
+		}
+	}
+
+	limit := defaultLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxLimit {
+			response.Fail(c, http.StatusBadRequest, "Invalid limit number")
+			return
+		}
+	}
+
+	result, err := response.WithLinks(c, h.Index.Lookup(q, types, limit), search.ItemLinks(c))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{Message: "Success retrieved suggestions"})
+}
@@ -0,0 +1,216 @@
+package suggest
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"my-gin-app/search"
+)
+
+const (
+	TypeCharacter   = "character"
+	TypeTailedBeast = "tailedbeast"
+)
+
+// Entry adalah satu saran autocomplete
+type Entry struct {
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Image string `json:"image"`
+}
+
+// Suggestion adalah Entry yang cocok beserta skornya
+type Suggestion struct {
+	Entry
+	Score float64 `json:"score"`
+}
+
+// prefixKey adalah satu kunci di index; setiap kata nama punya kunci sendiri
+// supaya "uchi" juga menemukan "Sasuke Uchiha"
+type prefixKey struct {
+	key       string
+	id        string
+	wordIndex int
+}
+
+type item struct {
+	entry      Entry
+	popularity int64
+}
+
+// Index adalah prefix index in-memory untuk autocomplete. Kunci disimpan terurut
+// sehingga pencarian prefix cukup binary search lalu scan rentang yang cocok.
+type Index struct {
+	mu    sync.RWMutex
+	items map[string]*item
+	keys  []prefixKey
+}
+
+func NewIndex() *Index {
+	return &Index{
+		items: map[string]*item{},
+	}
+}
+
+func entryID(typ, slug string) string {
+	return typ + "/" + slug
+}
+
+// Put menambah atau mengganti entry; popularitas entry yang sudah ada dipertahankan
+func (idx *Index) Put(e Entry) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.put(e)
+}
+
+// Remove menghapus entry berdasarkan type dan slug
+func (idx *Index) Remove(typ, slug string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(entryID(typ, slug))
+}
+
+// Replace mengganti semua entry dari satu type, dipakai saat load awal dan reslug
+func (idx *Index) Replace(typ string, entries []Entry) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for id, it := range idx.items {
+		if it.entry.Type == typ {
+			delete(idx.items, id)
+		}
+	}
+	kept := idx.keys[:0]
+	for _, k := range idx.keys {
+		if _, ok := idx.items[k.id]; ok {
+			kept = append(kept, k)
+		}
+	}
+	idx.keys = kept
+
+	for _, e := range entries {
+		idx.put(e)
+	}
+}
+
+// Hit menaikkan popularitas entry, dipanggil setiap kali detailnya dibaca
+func (idx *Index) Hit(typ, slug string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if it, ok := idx.items[entryID(typ, slug)]; ok {
+		it.popularity++
+	}
+}
+
+// Lookup mencari entry yang namanya diawali q. types kosong berarti semua type.
+// Urutan: awal nama lengkap lebih dulu dari awal kata lain, lalu popularitas, lalu nama terpendek.
+func (idx *Index) Lookup(q string, types []string, limit int) []Suggestion {
+	prefix := search.Fold(q)
+	if prefix == "" {
+		return nil
+	}
+
+	allowed := map[string]bool{}
+	for _, t := range types {
+		allowed[t] = true
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	start := sort.Search(len(idx.keys), func(i int) bool {
+		return idx.keys[i].key >= prefix
+	})
+
+	best := map[string]Suggestion{}
+	for i := start; i < len(idx.keys) && strings.HasPrefix(idx.keys[i].key, prefix); i++ {
+		k := idx.keys[i]
+		it := idx.items[k.id]
+		if len(allowed) > 0 && !allowed[it.entry.Type] {
+			continue
+		}
+
+		score := search.ScoreWord
+		if k.wordIndex == 0 {
+			score = search.ScorePrefix
+		}
+		if k.key == prefix {
+			score = search.ScoreExact
+		}
+		if current, ok := best[k.id]; !ok || score > current.Score {
+			best[k.id] = Suggestion{Entry: it.entry, Score: score}
+		}
+	}
+
+	suggestions := make([]Suggestion, 0, len(best))
+	for _, s := range best {
+		suggestions = append(suggestions, s)
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		pa := idx.items[entryID(a.Type, a.Slug)].popularity
+		pb := idx.items[entryID(b.Type, b.Slug)].popularity
+		if pa != pb {
+			return pa > pb
+		}
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+		return a.Name < b.Name
+	})
+
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+func (idx *Index) put(e Entry) {
+	id := entryID(e.Type, e.Slug)
+	var popularity int64
+	if old, ok := idx.items[id]; ok {
+		popularity = old.popularity
+		idx.remove(id)
+	}
+
+	words := strings.Fields(search.Fold(e.Name))
+	for i := range words {
+		// kunci = nama mulai dari kata ke-i, jadi "uchi" juga menemukan "Sasuke Uchiha"
+		idx.insertKey(prefixKey{key: strings.Join(words[i:], " "), id: id, wordIndex: i})
+	}
+	idx.items[id] = &item{entry: e, popularity: popularity}
+}
+
+func (idx *Index) remove(id string) {
+	if _, ok := idx.items[id]; !ok {
+		return
+	}
+	idx.removeKeys(id)
+	delete(idx.items, id)
+}
+
+func (idx *Index) insertKey(k prefixKey) {
+	i := sort.Search(len(idx.keys), func(i int) bool {
+		return idx.keys[i].key >= k.key
+	})
+	idx.keys = append(idx.keys, prefixKey{})
+	copy(idx.keys[i+1:], idx.keys[i:])
+	idx.keys[i] = k
+}
+
+func (idx *Index) removeKeys(id string) {
+	kept := idx.keys[:0]
+	for _, k := range idx.keys {
+		if k.id != id {
+			kept = append(kept, k)
+		}
+	}
+	idx.keys = kept
+}
@@ -0,0 +1,24 @@
+package suggest
+
+import (
+	"net/http"
+
+	"my-gin-app/openapi"
+)
+
+// Operations mendokumentasikan route autocomplete untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/suggest", Tag: "search",
+			Summary: "Autocomplete names by prefix",
+			Query: []openapi.Param{
+				{Name: "q", Required: true, Description: "Name prefix"},
+				{Name: "types", Description: "Comma separated resource types"},
+				{Name: "limit", Type: "integer", Description: "Maximum suggestions, 1-50 (default 10)"},
+			},
+			Result: []Suggestion{},
+			Linked: true,
+		},
+	}
+}
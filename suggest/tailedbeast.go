@@ -0,0 +1,102 @@
+package suggest
+
+import (
+	"context"
+
+	"github.com/gosimple/slug"
+
+	"my-gin-app/models"
+	"my-gin-app/tailedbeast"
+)
+
+// beastService membungkus tailedbeast.Service supaya index ikut diperbarui di setiap Create/Update/Delete
+type beastService struct {
+	tailedbeast.Service
+	index *Index
+}
+
+// BeastService mengembalikan tailedbeast.Service yang menjaga index tetap sinkron
+func BeastService(inner tailedbeast.Service, index *Index) tailedbeast.Service {
+	return &beastService{
+		Service: inner,
+		index:   index,
+	}
+}
+
+// LoadBeasts mengisi index dengan semua tailed beast yang ada
+func LoadBeasts(ctx context.Context, index *Index, service tailedbeast.Service) error {
+	beasts, _, err := service.ListBeasts(ctx, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]Entry, len(beasts))
+	for i, b := range beasts {
+		entries[i] = beastEntry(&b)
+	}
+	index.Replace(TypeTailedBeast, entries)
+	return nil
+}
+
+func beastEntry(b *models.TailedBeast) Entry {
+	e := Entry{Slug: b.Slug, Name: b.Name, Type: TypeTailedBeast}
+	if len(b.Images) > 0 {
+		e.Image = b.Images[0]
+	}
+	return e
+}
+
+func (s *beastService) CreateBeast(ctx context.Context, b *models.TailedBeast) error {
+	if err := s.Service.CreateBeast(ctx, b); err != nil {
+		return err
+	}
+	s.index.Put(beastEntry(b))
+	return nil
+}
+
+func (s *beastService) GetBeastBySlug(ctx context.Context, slugParam string, fields ...string) (*models.TailedBeast, error) {
+	b, err := s.Service.GetBeastBySlug(ctx, slugParam, fields...)
+	if err == nil {
+		s.index.Hit(TypeTailedBeast, b.Slug)
+	}
+	return b, err
+}
+
+func (s *beastService) UpdateBeast(ctx context.Context, slugParam string, updatedData *models.TailedBeast) error {
+	if err := s.Service.UpdateBeast(ctx, slugParam, updatedData); err != nil {
+		return err
+	}
+
+	newSlug := slugParam
+	if updatedData.Name != "" {
+		newSlug = slug.Make(updatedData.Name)
+	}
+	updated, err := s.Service.GetBeastBySlug(ctx, newSlug)
+	if err != nil {
+		// update sudah tersimpan; index cukup sinkron lagi saat reload berikutnya
+		return nil
+	}
+	if newSlug != slugParam {
+		s.index.Remove(TypeTailedBeast, slugParam)
+	}
+	s.index.Put(beastEntry(updated))
+	return nil
+}
+
+func (s *beastService) DeleteBeast(ctx context.Context, slugParam string) error {
+	if err := s.Service.DeleteBeast(ctx, slugParam); err != nil {
+		return err
+	}
+	s.index.Remove(TypeTailedBeast, slugParam)
+	return nil
+}
+
+func (s *beastService) ReslugBeasts(ctx context.Context) (int, error) {
+	n, err := s.Service.ReslugBeasts(ctx)
+	if n > 0 {
+		if loadErr := LoadBeasts(ctx, s.index, s.Service); err == nil {
+			err = loadErr
+		}
+	}
+	return n, err
+}
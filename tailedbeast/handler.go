@@ -1,10 +1,15 @@
 package tailedbeast
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"my-gin-app/fields"
 	"my-gin-app/models"
+	"my-gin-app/response"
+	"my-gin-app/search"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gosimple/slug"
@@ -24,35 +29,53 @@ func NewHandler(service Service) *Handler {
 func (h *Handler) CreateTailedBeast(c *gin.Context) {
 	var beast models.TailedBeast
 	if err := c.ShouldBindJSON(&beast); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	if err := h.Service.CreateBeast(&beast); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.Service.CreateBeast(c.Request.Context(), &beast); err != nil {
+		if err.Error() == "tailed beast already exists" {
+			response.Fail(c, http.StatusConflict, "Tailed Beast Already Exists")
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"result": beast})
+	result, err := present(c, beast, nil)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.OK(c, http.StatusCreated, result, nil)
 }
 
 // ReadTailedBeast handler untuk membaca tailedbeast berdasarkan slug
 func (h *Handler) ReadTailedBeast(c *gin.Context) {
 	slugParam := c.Param("slug")
-	beast, err := h.Service.GetBeastBySlug(slugParam)
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	beast, err := h.Service.GetBeastBySlug(c.Request.Context(), slugParam, selected...)
 	if err != nil {
 		if err.Error() == "tailed beast not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Tailed Beast Not Found"})
+			response.Fail(c, http.StatusNotFound, "Tailed Beast Not Found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Success retrieved data",
-		"result":  beast,
-	})
+	result, err := present(c, beast, selected)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{Message: "Success retrieved data"})
 }
 
 // UpdateTailedBeast handler untuk memperbarui tailedbeast berdasarkan slug
@@ -60,47 +83,52 @@ func (h *Handler) UpdateTailedBeast(c *gin.Context) {
 	slugParam := c.Param("slug")
 	var updatedData models.TailedBeast
 	if err := c.ShouldBindJSON(&updatedData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	if err := h.Service.UpdateBeast(slugParam, &updatedData); err != nil {
+	if err := h.Service.UpdateBeast(c.Request.Context(), slugParam, &updatedData); err != nil {
 		if err.Error() == "tailed beast not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Tailed Beast Not Found"})
+			response.Fail(c, http.StatusNotFound, "Tailed Beast Not Found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	updatedBeast, err := h.Service.GetBeastBySlug(slug.Make(updatedData.Name))
+	updatedBeast, err := h.Service.GetBeastBySlug(c.Request.Context(), slug.Make(updatedData.Name))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve updated tailed beast"})
+		response.Fail(c, http.StatusInternalServerError, "Failed to retrieve updated tailed beast")
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"status":  "success",
-		"message": "Tailed Beast updated",
-		"result":  updatedBeast,
+	result, err := present(c, updatedBeast, nil)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.OK(c, http.StatusOK, result, &response.Meta{
+		Message: "Tailed Beast updated",
+		Legacy:  gin.H{"status": "success"},
 	})
 }
 
 // DeleteTailedBeast handler untuk menghapus tailedbeast berdasarkan slug
 func (h *Handler) DeleteTailedBeast(c *gin.Context) {
 	slugParam := c.Param("slug")
-	if err := h.Service.DeleteBeast(slugParam); err != nil {
+	if err := h.Service.DeleteBeast(c.Request.Context(), slugParam); err != nil {
 		if err.Error() == "tailed beast not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Tailed Beast Not Found"})
+			response.Fail(c, http.StatusNotFound, "Tailed Beast Not Found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
-	c.JSON(http.StatusNoContent, gin.H{"message": "Tailed Beast deleted"})
+	c.Status(http.StatusNoContent)
 }
 
-// IndexTailedBeast handler untuk mengambil daftar tailedbeast dengan pagination
+// IndexTailedBeast handler untuk mengambil daftar tailedbeast dengan pagination, filter dan facet
 func (h *Handler) IndexTailedBeast(c *gin.Context) {
 	pageStr := c.Query("page")
 	limitStr := c.Query("limit")
@@ -111,7 +139,7 @@ func (h *Handler) IndexTailedBeast(c *gin.Context) {
 	if pageStr != "" {
 		page, err = strconv.Atoi(pageStr)
 		if err != nil || page < 1 {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page number"})
+			response.Fail(c, http.StatusBadRequest, "Invalid page number")
 			return
 		}
 	}
@@ -119,55 +147,223 @@ func (h *Handler) IndexTailedBeast(c *gin.Context) {
 	if limitStr != "" {
 		limit, err = strconv.Atoi(limitStr)
 		if err != nil || limit < 1 {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit number"})
+			response.Fail(c, http.StatusBadRequest, "Invalid limit number")
 			return
 		}
 	}
 
-	beasts, count, err := h.Service.ListBeasts(page, limit)
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	filters := queryFilters(c)
+	beasts, count, err := h.Service.ListBeasts(c.Request.Context(), page, limit, filters, selected...)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	result, err := present(c, beasts, selected)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	facets, ok := h.facets(c, models.FacetScope{Filters: filters})
+	if !ok {
 		return
 	}
 
+	meta := &response.Meta{
+		Message: "Success retrieved all data",
+		Facets:  facets,
+	}
 	if page > 0 && limit > 0 {
-		totalPages := (count + int64(limit) - 1) / int64(limit)
-		c.JSON(http.StatusOK, gin.H{
-			"message":    "Success retrieved data",
-			"result":     beasts,
-			"page":       page,
-			"limit":      limit,
-			"totalPages": totalPages,
-			"totalItems": count,
-		})
-	} else {
-		c.JSON(http.StatusOK, gin.H{
-			"message": "Success retrieved all data",
-			"result":  beasts,
-		})
+		meta.Message = "Success retrieved data"
+		meta.Pagination = &response.Pagination{
+			Page:       page,
+			Limit:      limit,
+			TotalPages: (count + int64(limit) - 1) / int64(limit),
+			TotalItems: count,
+		}
 	}
+	response.List(c, result, meta)
 }
 
-// SearchTailedBeast handler untuk mencari tailedbeast berdasarkan nama
+// SearchTailedBeast handler untuk mencari tailedbeast berdasarkan nama, atau full-text lewat parameter q
 func (h *Handler) SearchTailedBeast(c *gin.Context) {
+	if q := c.Query("q"); q != "" {
+		h.textSearch(c, q)
+		return
+	}
+
 	nameQuery := c.Query("name")
 	if nameQuery == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Name query parameter is required"})
+		response.Fail(c, http.StatusBadRequest, "Name or q query parameter is required")
+		return
+	}
+
+	page, limit, ok := queryPage(c)
+	if !ok {
 		return
 	}
 
-	beasts, err := h.Service.SearchBeasts(nameQuery)
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	beasts, err := h.Service.SearchBeasts(c.Request.Context(), nameQuery, selected...)
 	if err != nil {
 		if err.Error() == "no tailed beasts found" {
-			c.JSON(http.StatusNotFound, gin.H{"message": "No tailed beasts found"})
+			var didYouMean string
+			var noMatch *search.NoMatchError
+			if errors.As(err, &noMatch) {
+				didYouMean = noMatch.DidYouMean
+			}
+			response.NoMatch(c, "No tailed beasts found", didYouMean)
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			response.Fail(c, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Found tailed beasts",
-		"result":  beasts,
-	})
+	slugs := make([]string, len(beasts))
+	for i, item := range beasts {
+		slugs[i] = item.Slug
+	}
+	facets, ok := h.facets(c, models.FacetScope{Slugs: slugs})
+	if !ok {
+		return
+	}
+
+	meta := &response.Meta{Facets: facets}
+	if page > 0 && limit > 0 {
+		beasts, meta.Pagination = response.Paginate(beasts, page, limit)
+	}
+
+	result, err := present(c, beasts, selected)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	meta.Message = "Found tailed beasts"
+	response.List(c, result, meta)
+}
+
+// textSearch menjalankan full-text search dengan skor relevansi dan highlight
+func (h *Handler) textSearch(c *gin.Context, q string) {
+	page, limit, ok := queryPage(c)
+	if !ok {
+		return
+	}
+	// dengan page, limit adalah ukuran halaman; tanpa page, limit adalah jumlah hit maksimum
+	maxHits := limit
+	if page > 0 && limit > 0 {
+		maxHits = 0
+	}
+
+	selected, ok := queryFields(c)
+	if !ok {
+		return
+	}
+
+	hits, err := h.Service.TextSearchBeasts(c.Request.Context(), q, maxHits, selected...)
+	if err != nil {
+		if err.Error() == "no tailed beasts found" {
+			response.NoMatch(c, "No tailed beasts found", "")
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	facets, ok := h.facets(c, models.FacetScope{Text: q})
+	if !ok {
+		return
+	}
+
+	meta := &response.Meta{Message: "Found tailed beasts", Facets: facets}
+	if page > 0 && limit > 0 {
+		hits, meta.Pagination = response.Paginate(hits, page, limit)
+	}
+
+	result, err := present(c, hits, selected, "score", "highlights")
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.List(c, result, meta)
+}
+
+// facets menghitung facet yang diminta lewat parameter facets=clan,status; nil jika tidak diminta.
+// ok=false berarti respons error sudah ditulis.
+func (h *Handler) facets(c *gin.Context, scope models.FacetScope) (models.Facets, bool) {
+	facetsStr := c.Query("facets")
+	if facetsStr == "" {
+		return nil, true
+	}
+
+	var names []string
+	for _, name := range strings.Split(facetsStr, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	facets, err := h.Service.BeastFacets(c.Request.Context(), names, scope)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid facet") {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return nil, false
+	}
+	return facets, true
+}
+
+// queryFilters mengambil filter exact-match dari query string, misal ?clan=Uchiha+Clan
+func queryFilters(c *gin.Context) map[string]string {
+	filters := map[string]string{}
+	for name := range FacetFields {
+		if value := c.Query(name); value != "" {
+			filters[name] = value
+		}
+	}
+	return filters
+}
+
+// queryFields membaca sparse fieldset ?fields=name,slug,personal.clan; ok=false berarti respons error sudah ditulis
+func queryFields(c *gin.Context) ([]string, bool) {
+	selected, err := fields.Parse(c.Query("fields"), models.TailedBeast{})
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return selected, true
+}
+
+// queryPage membaca ?page=&limit= untuk pencarian; halaman hanya dipakai jika keduanya diisi
+func queryPage(c *gin.Context) (page, limit int, ok bool) {
+	if pageStr := c.Query("page"); pageStr != "" {
+		var err error
+		page, err = strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			response.Fail(c, http.StatusBadRequest, "Invalid page number")
+			return 0, 0, false
+		}
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			response.Fail(c, http.StatusBadRequest, "Invalid limit number")
+			return 0, 0, false
+		}
+	}
+	return page, limit, true
 }
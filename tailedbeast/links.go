@@ -0,0 +1,34 @@
+package tailedbeast
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"my-gin-app/character"
+	"my-gin-app/fields"
+	"my-gin-app/response"
+)
+
+// Links membuat _links HAL untuk satu tailed beast; jinchuriki menunjuk karakter host jika diketahui
+func Links(c *gin.Context, slugValue, jinchuriki string) response.Links {
+	links := response.Links{
+		"self":       {Href: response.URL(c, "/tailedbeast/"+slugValue, nil)},
+		"collection": {Href: response.URL(c, "/tailedbeast", nil)},
+	}
+	if jinchuriki != "" {
+		links["jinchuriki"] = character.Links(c, jinchuriki)["self"]
+	}
+	return links
+}
+
+// present menambahkan _links lalu memangkas hasil ke sparse fieldset; slug dan jinchuriki selalu ada karena ikut di projection
+func present(c *gin.Context, v interface{}, selected []string, keep ...string) (interface{}, error) {
+	linked, err := response.WithLinks(c, v, func(doc map[string]interface{}) response.Links {
+		slugValue, _ := doc["slug"].(string)
+		jinchuriki, _ := doc["jinchuriki"].(string)
+		return Links(c, slugValue, jinchuriki)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields.Select(linked, selected, append(keep, "_links")...)
+}
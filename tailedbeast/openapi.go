@@ -0,0 +1,80 @@
+package tailedbeast
+
+import (
+	"net/http"
+	"sort"
+
+	"my-gin-app/models"
+	"my-gin-app/openapi"
+)
+
+const tag = "tailedbeast"
+
+// Operations mendokumentasikan route tailed beast untuk dokumen OpenAPI
+func Operations() []openapi.Operation {
+	fieldsParam := openapi.Param{Name: "fields", Description: "Comma separated sparse fieldset, e.g. name,rank"}
+	facetsParam := openapi.Param{Name: "facets", Description: "Comma separated facet counts to include"}
+
+	index := []openapi.Param{
+		{Name: "page", Type: "integer", Description: "Page number, requires limit"},
+		{Name: "limit", Type: "integer", Description: "Page size, requires page"},
+		fieldsParam,
+		facetsParam,
+	}
+	index = append(index, filterParams()...)
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/tailedbeast", Tag: tag,
+			Summary: "List tailed beasts with optional pagination, filters and facets",
+			Query:   index, Result: []models.TailedBeast{}, Linked: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/tailedbeast/search", Tag: tag,
+			Summary: "Search tailed beasts by fuzzy name or full-text query",
+			Query: []openapi.Param{
+				{Name: "name", Description: "Fuzzy name match, required when q is empty"},
+				{Name: "q", Description: "Full-text query with relevance score and highlights"},
+				{Name: "page", Type: "integer", Description: "Page number, requires limit"},
+				{Name: "limit", Type: "integer", Description: "Page size with page, otherwise maximum full-text hits"},
+				fieldsParam,
+				facetsParam,
+			},
+			Result: []models.TailedBeastHit{}, Linked: true,
+		},
+		{
+			Method: http.MethodPost, Path: "/tailedbeast", Tag: tag,
+			Summary: "Create a tailed beast",
+			Body:    models.TailedBeast{}, Status: http.StatusCreated, Result: models.TailedBeast{}, Linked: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/tailedbeast/:slug", Tag: tag,
+			Summary: "Get a tailed beast by slug",
+			Query:   []openapi.Param{fieldsParam}, Result: models.TailedBeast{}, Linked: true,
+		},
+		{
+			Method: http.MethodPut, Path: "/tailedbeast/:slug", Tag: tag,
+			Summary: "Update a tailed beast by slug",
+			Body:    models.TailedBeast{}, Result: models.TailedBeast{}, Linked: true,
+		},
+		{
+			Method: http.MethodDelete, Path: "/tailedbeast/:slug", Tag: tag,
+			Summary: "Delete a tailed beast by slug",
+			Status:  http.StatusNoContent,
+		},
+	}
+}
+
+func filterParams() []openapi.Param {
+	names := make([]string, 0, len(FacetFields))
+	for name := range FacetFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]openapi.Param, len(names))
+	for i, name := range names {
+		params[i] = openapi.Param{Name: name, Description: "Exact-match filter"}
+	}
+	return params
+}
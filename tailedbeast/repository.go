@@ -3,21 +3,56 @@ package tailedbeast
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"my-gin-app/database"
+	"my-gin-app/fields"
 	"my-gin-app/models"
 )
 
 type Repository interface {
-	Create(beast *models.TailedBeast) error
-	FindBySlug(slug string) (*models.TailedBeast, error)
-	UpdateBySlug(slug string, update bson.M) error
-	DeleteBySlug(slug string) error
-	ListBeasts(skip int64, limit int64) ([]models.TailedBeast, error)
-	CountBeasts() (int64, error)
+	Create(ctx context.Context, beast *models.TailedBeast) error
+	FindBySlug(ctx context.Context, slug string, fields ...string) (*models.TailedBeast, error)
+	UpdateBySlug(ctx context.Context, slug string, update bson.M) error
+	DeleteBySlug(ctx context.Context, slug string) error
+	ListBeasts(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) ([]models.TailedBeast, error)
+	CountBeasts(ctx context.Context, filter bson.M) (int64, error)
+	Facets(ctx context.Context, filter bson.M, facets []string) (models.Facets, error)
+	Stats(ctx context.Context, groupBy string) (*models.TailedBeastStats, error)
+	TextSearch(ctx context.Context, query string, limit int64, fields ...string) ([]models.TailedBeastHit, error)
+	EnsureIndexes(ctx context.Context) ([]database.IndexStatus, error)
+	IndexStatus(ctx context.Context) ([]database.IndexStatus, error)
+}
+
+// Indexes adalah index yang dibutuhkan query tailed beast; direkonsiliasi saat startup
+var Indexes = []database.Index{
+	{Name: "slug_unique", Keys: bson.D{{Key: "slug", Value: 1}}, Unique: true},
+	{
+		Name: "tailedbeast_text",
+		Keys: bson.D{
+			{Key: "name", Value: "text"},
+			{Key: "abilities", Value: "text"},
+			{Key: "personality", Value: "text"},
+		},
+		Weights: bson.D{
+			{Key: "name", Value: 10},
+			{Key: "abilities", Value: 5},
+			{Key: "personality", Value: 1},
+		},
+	},
+	{Name: "rank_name", Keys: bson.D{{Key: "rank", Value: 1}, {Key: "name", Value: 1}}},
+	// relasi Character.tailedBeasts dan link jinchuriki mencari tailed beast berdasarkan slug host
+	{Name: "jinchuriki_1", Keys: bson.D{{Key: "jinchuriki", Value: 1}}},
+}
+
+// FacetFields memetakan nama facet/filter publik ke path field di dokumen
+var FacetFields = map[string]string{
+	"rank":       "rank",
+	"jinchuriki": "jinchuriki",
 }
 
 type MongoRepository struct {
@@ -30,14 +65,22 @@ func NewRepository(collection *mongo.Collection) Repository {
 	}
 }
 
-func (r *MongoRepository) Create(beast *models.TailedBeast) error {
-	_, err := r.Collection.InsertOne(context.Background(), beast)
+func (r *MongoRepository) Create(ctx context.Context, beast *models.TailedBeast) error {
+	_, err := r.Collection.InsertOne(ctx, beast)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New("tailed beast already exists")
+	}
 	return err
 }
 
-func (r *MongoRepository) FindBySlug(slug string) (*models.TailedBeast, error) {
+// FindBySlug mengambil satu dokumen; fields (opsional) membatasi field yang diambil dari database
+func (r *MongoRepository) FindBySlug(ctx context.Context, slug string, fields ...string) (*models.TailedBeast, error) {
 	var beast models.TailedBeast
-	err := r.Collection.FindOne(context.Background(), bson.M{"slug": slug}).Decode(&beast)
+	findOptions := options.FindOne()
+	if projection := fieldsProjection(fields); projection != nil {
+		findOptions.SetProjection(projection)
+	}
+	err := r.Collection.FindOne(ctx, bson.M{"slug": slug}, findOptions).Decode(&beast)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, errors.New("tailed beast not found")
@@ -47,30 +90,33 @@ func (r *MongoRepository) FindBySlug(slug string) (*models.TailedBeast, error) {
 	return &beast, nil
 }
 
-func (r *MongoRepository) UpdateBySlug(slug string, update bson.M) error {
-	_, err := r.Collection.UpdateOne(context.Background(), bson.M{"slug": slug}, bson.M{"$set": update})
+func (r *MongoRepository) UpdateBySlug(ctx context.Context, slug string, update bson.M) error {
+	_, err := r.Collection.UpdateOne(ctx, bson.M{"slug": slug}, bson.M{"$set": update})
 	return err
 }
 
-func (r *MongoRepository) DeleteBySlug(slug string) error {
-	_, err := r.Collection.DeleteOne(context.Background(), bson.M{"slug": slug})
+func (r *MongoRepository) DeleteBySlug(ctx context.Context, slug string) error {
+	_, err := r.Collection.DeleteOne(ctx, bson.M{"slug": slug})
 	return err
 }
 
-func (r *MongoRepository) ListBeasts(skip int64, limit int64) ([]models.TailedBeast, error) {
+func (r *MongoRepository) ListBeasts(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) ([]models.TailedBeast, error) {
 	var beasts []models.TailedBeast
 	findOptions := options.Find()
 	if limit > 0 {
 		findOptions.SetSkip(skip).SetLimit(limit)
 	}
+	if projection := fieldsProjection(fields); projection != nil {
+		findOptions.SetProjection(projection)
+	}
 
-	cursor, err := r.Collection.Find(context.Background(), bson.D{}, findOptions)
+	cursor, err := r.Collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 
-	for cursor.Next(context.Background()) {
+	for cursor.Next(ctx) {
 		var beast models.TailedBeast
 		if err := cursor.Decode(&beast); err != nil {
 			return nil, err
@@ -81,6 +127,139 @@ func (r *MongoRepository) ListBeasts(skip int64, limit int64) ([]models.TailedBe
 	return beasts, nil
 }
 
-func (r *MongoRepository) CountBeasts() (int64, error) {
-	return r.Collection.CountDocuments(context.Background(), bson.D{})
+func (r *MongoRepository) CountBeasts(ctx context.Context, filter bson.M) (int64, error) {
+	return r.Collection.CountDocuments(ctx, filter)
+}
+
+// Facets menghitung jumlah dokumen per nilai untuk setiap facet dalam satu aggregation $facet
+func (r *MongoRepository) Facets(ctx context.Context, filter bson.M, facets []string) (models.Facets, error) {
+	// nama output $facet tidak boleh mengandung titik (rank.ninjaRank), jadi stage diberi nama aman lalu dipetakan balik
+	stages := bson.M{}
+	names := map[string]string{}
+	for _, name := range facets {
+		stage := strings.ReplaceAll(name, ".", "_")
+		names[stage] = name
+		stages[stage] = bson.A{
+			bson.M{"$group": bson.M{"_id": "$" + FacetFields[name], "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$facet", Value: stages}},
+	}
+
+	cursor, err := r.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	result := models.Facets{}
+	if cursor.Next(ctx) {
+		var raw map[string][]models.FacetBucket
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		for stage, buckets := range raw {
+			result[names[stage]] = buckets
+		}
+	}
+	return result, cursor.Err()
+}
+
+// TextSearch mencari lewat text index; relevansi memakai bobot field yang didefinisikan di Indexes
+func (r *MongoRepository) TextSearch(ctx context.Context, query string, limit int64, fields ...string) ([]models.TailedBeastHit, error) {
+	score := bson.M{"$meta": "textScore"}
+	projection := fieldsProjection(fields)
+	if projection == nil {
+		projection = bson.M{}
+	}
+	projection["score"] = score
+
+	findOptions := options.Find().
+		SetProjection(projection).
+		SetSort(bson.D{{Key: "score", Value: score}})
+	if limit > 0 {
+		findOptions.SetLimit(limit)
+	}
+
+	cursor, err := r.Collection.Find(ctx, bson.M{"$text": bson.M{"$search": query}}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var hits []models.TailedBeastHit
+	if err := cursor.All(ctx, &hits); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+var abilityCount = bson.M{"$size": bson.M{"$ifNull": bson.A{"$abilities", bson.A{}}}}
+
+// Stats menjalankan satu aggregation $facet untuk statistik dashboard; groupBy (path field) opsional
+func (r *MongoRepository) Stats(ctx context.Context, groupBy string) (*models.TailedBeastStats, error) {
+	facets := bson.M{
+		"total": bson.A{bson.M{"$group": bson.M{"_id": nil, "count": bson.M{"$sum": 1}, "averageAbilities": bson.M{"$avg": abilityCount}}}},
+		"byRank": bson.A{
+			bson.M{"$group": bson.M{"_id": "$rank", "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		},
+	}
+	if groupBy != "" {
+		facets["groups"] = bson.A{
+			bson.M{"$group": bson.M{"_id": "$" + groupBy, "count": bson.M{"$sum": 1}, "averageAbilities": bson.M{"$avg": abilityCount}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		}
+	}
+
+	cursor, err := r.Collection.Aggregate(ctx, mongo.Pipeline{{{Key: "$facet", Value: facets}}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		Total []struct {
+			Count            int64   `bson:"count"`
+			AverageAbilities float64 `bson:"averageAbilities"`
+		} `bson:"total"`
+		ByRank []models.FacetBucket      `bson:"byRank"`
+		Groups []models.TailedBeastGroup `bson:"groups"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	stats := &models.TailedBeastStats{
+		ByRank: result.ByRank,
+		Groups: result.Groups,
+	}
+	if len(result.Total) > 0 {
+		stats.Total = result.Total[0].Count
+		stats.AverageAbilities = result.Total[0].AverageAbilities
+	}
+	return stats, nil
+}
+
+// fieldsProjection selalu menyertakan slug dan name karena dipakai untuk ranking, index autocomplete dan link,
+// serta jinchuriki untuk link ke karakter host
+func fieldsProjection(paths []string) bson.M {
+	return fields.Projection(paths, "slug", "name", "jinchuriki")
+}
+
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) ([]database.IndexStatus, error) {
+	return database.EnsureIndexes(ctx, r.Collection, Indexes)
+}
+
+func (r *MongoRepository) IndexStatus(ctx context.Context) ([]database.IndexStatus, error) {
+	return database.CheckIndexes(ctx, r.Collection, Indexes)
 }
@@ -0,0 +1,58 @@
+package tailedbeast
+
+import (
+	"context"
+
+	"my-gin-app/models"
+	"my-gin-app/search"
+)
+
+// searchResource menghubungkan Service tailed beast ke pencarian gabungan /search
+type searchResource struct {
+	service Service
+}
+
+func NewSearchResource(service Service) search.Resource {
+	return &searchResource{
+		service: service,
+	}
+}
+
+func (r *searchResource) Type() string {
+	return "tailedbeast"
+}
+
+// Search menggabungkan full-text search dan pencarian nama fuzzy supaya typo tetap menemukan hasil
+func (r *searchResource) Search(ctx context.Context, q string, limit int) ([]search.Result, error) {
+	hits, err := r.service.TextSearchBeasts(ctx, q, limit)
+	if err != nil && err.Error() != "no tailed beasts found" {
+		return nil, err
+	}
+
+	text := make([]search.Result, len(hits))
+	for i, h := range hits {
+		text[i] = search.Result{Type: r.Type(), Slug: h.Slug, Name: h.Name, Score: h.Score}
+	}
+	search.NormalizeScores(text)
+	for i := range text {
+		hits[i].Score = text[i].Score
+		text[i].Data = hits[i]
+	}
+
+	beasts, err := r.service.SearchBeasts(ctx, q)
+	if err != nil && err.Error() != "no tailed beasts found" {
+		return nil, err
+	}
+
+	terms := search.Terms(q)
+	fuzzy := make([]search.Result, len(beasts))
+	for i, b := range beasts {
+		hit := models.TailedBeastHit{TailedBeast: b, Score: search.NameScore(q, b.Name)}
+		if h, ok := search.Highlight("name", b.Name, terms); ok {
+			hit.Highlights = []models.Highlight{h}
+		}
+		fuzzy[i] = search.Result{Type: r.Type(), Slug: b.Slug, Name: b.Name, Score: hit.Score, Data: hit}
+	}
+
+	return search.Merge(limit, text, fuzzy), nil
+}
@@ -1,20 +1,30 @@
 package tailedbeast
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"my-gin-app/models"
+	"my-gin-app/search"
 
 	"github.com/gosimple/slug"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type Service interface {
-	CreateBeast(beast *models.TailedBeast) error
-	GetBeastBySlug(slug string) (*models.TailedBeast, error)
-	UpdateBeast(slug string, updatedData *models.TailedBeast) error
-	DeleteBeast(slug string) error
-	ListBeasts(page int, limit int) ([]models.TailedBeast, int64, error)
-	SearchBeasts(name string) ([]models.TailedBeast, error)
+	CreateBeast(ctx context.Context, beast *models.TailedBeast) error
+	GetBeastBySlug(ctx context.Context, slug string, fields ...string) (*models.TailedBeast, error)
+	GetBeastsBySlugs(ctx context.Context, slugs []string) ([]models.TailedBeast, error)
+	GetBeastsByJinchuriki(ctx context.Context, characterSlugs []string) ([]models.TailedBeast, error)
+	UpdateBeast(ctx context.Context, slug string, updatedData *models.TailedBeast) error
+	DeleteBeast(ctx context.Context, slug string) error
+	ListBeasts(ctx context.Context, page int, limit int, filters map[string]string, fields ...string) ([]models.TailedBeast, int64, error)
+	BeastFacets(ctx context.Context, facets []string, scope models.FacetScope) (models.Facets, error)
+	BeastStats(ctx context.Context, groupBy string) (*models.TailedBeastStats, error)
+	SearchBeasts(ctx context.Context, name string, fields ...string) ([]models.TailedBeast, error)
+	TextSearchBeasts(ctx context.Context, q string, limit int, fields ...string) ([]models.TailedBeastHit, error)
+	ReslugBeasts(ctx context.Context) (int, error)
 }
 
 type service struct {
@@ -27,17 +37,17 @@ func NewService(repo Repository) Service {
 	}
 }
 
-func (s *service) CreateBeast(beast *models.TailedBeast) error {
+func (s *service) CreateBeast(ctx context.Context, beast *models.TailedBeast) error {
 	beast.Slug = slug.Make(beast.Name)
-	return s.repo.Create(beast)
+	return s.repo.Create(ctx, beast)
 }
 
-func (s *service) GetBeastBySlug(slug string) (*models.TailedBeast, error) {
-	return s.repo.FindBySlug(slug)
+func (s *service) GetBeastBySlug(ctx context.Context, slugParam string, fields ...string) (*models.TailedBeast, error) {
+	return s.repo.FindBySlug(ctx, slugParam, fields...)
 }
 
-func (s *service) UpdateBeast(slug string, updatedData *models.TailedBeast) error {
-	existingBeast, err := s.repo.FindBySlug(slug)
+func (s *service) UpdateBeast(ctx context.Context, slugParam string, updatedData *models.TailedBeast) error {
+	existingBeast, err := s.repo.FindBySlug(ctx, slugParam)
 	if err != nil {
 		return err
 	}
@@ -63,6 +73,9 @@ func (s *service) UpdateBeast(slug string, updatedData *models.TailedBeast) erro
 	if updatedData.Personality != "" {
 		existingBeast.Personality = updatedData.Personality
 	}
+	if updatedData.Jinchuriki != "" {
+		existingBeast.Jinchuriki = updatedData.Jinchuriki
+	}
 
 	update := map[string]interface{}{
 		"name":        existingBeast.Name,
@@ -71,16 +84,32 @@ func (s *service) UpdateBeast(slug string, updatedData *models.TailedBeast) erro
 		"rank":        existingBeast.Rank,
 		"abilities":   existingBeast.Abilities,
 		"personality": existingBeast.Personality,
+		"jinchuriki":  existingBeast.Jinchuriki,
 	}
 
-	return s.repo.UpdateBySlug(slug, update)
+	return s.repo.UpdateBySlug(ctx, slugParam, update)
+}
+
+// GetBeastsBySlugs mengambil banyak tailed beast sekaligus; slug yang tidak ada dilewati
+func (s *service) GetBeastsBySlugs(ctx context.Context, slugs []string) ([]models.TailedBeast, error) {
+	return s.repo.ListBeasts(ctx, bson.M{"slug": bson.M{"$in": slugs}}, 0, 0)
+}
+
+// GetBeastsByJinchuriki mengambil tailed beast yang host-nya salah satu dari characterSlugs
+func (s *service) GetBeastsByJinchuriki(ctx context.Context, characterSlugs []string) ([]models.TailedBeast, error) {
+	return s.repo.ListBeasts(ctx, bson.M{"jinchuriki": bson.M{"$in": characterSlugs}}, 0, 0)
 }
 
-func (s *service) DeleteBeast(slug string) error {
-	return s.repo.DeleteBySlug(slug)
+func (s *service) DeleteBeast(ctx context.Context, slugParam string) error {
+	return s.repo.DeleteBySlug(ctx, slugParam)
 }
 
-func (s *service) ListBeasts(page int, limit int) ([]models.TailedBeast, int64, error) {
+func (s *service) ListBeasts(ctx context.Context, page int, limit int, filters map[string]string, fields ...string) ([]models.TailedBeast, int64, error) {
+	filter, err := buildFilter(models.FacetScope{Filters: filters})
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var skip int64
 	var lim int64
 	if limit > 0 && page > 0 {
@@ -88,12 +117,12 @@ func (s *service) ListBeasts(page int, limit int) ([]models.TailedBeast, int64,
 		lim = int64(limit)
 	}
 
-	beasts, err := s.repo.ListBeasts(skip, lim)
+	beasts, err := s.repo.ListBeasts(ctx, filter, skip, lim, fields...)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	count, err := s.repo.CountBeasts()
+	count, err := s.repo.CountBeasts(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -101,30 +130,142 @@ func (s *service) ListBeasts(page int, limit int) ([]models.TailedBeast, int64,
 	return beasts, count, nil
 }
 
-func (s *service) SearchBeasts(name string) ([]models.TailedBeast, error) {
+func (s *service) SearchBeasts(ctx context.Context, name string, fields ...string) ([]models.TailedBeast, error) {
 	if name == "" {
 		return nil, errors.New("name query parameter is required")
 	}
 
-	beasts, err := s.repo.ListBeasts(0, 0)
+	beasts, err := s.repo.ListBeasts(ctx, bson.M{}, 0, 0, fields...)
 	if err != nil {
 		return nil, err
 	}
 
-	var filtered []models.TailedBeast
-	for _, b := range beasts {
-		if containsIgnoreCase(b.Name, name) {
-			filtered = append(filtered, b)
+	// Pencocokan fuzzy: toleran typo, makron/vokal panjang dan variasi romanisasi
+	names := make([]string, len(beasts))
+	for i, b := range beasts {
+		names[i] = b.Name
+	}
+
+	matches := search.Rank(name, names)
+	if len(matches) == 0 {
+		return nil, &search.NoMatchError{
+			Message:    "no tailed beasts found",
+			DidYouMean: search.Suggest(name, names),
 		}
 	}
 
-	if len(filtered) == 0 {
-		return nil, errors.New("no tailed beasts found")
+	filtered := make([]models.TailedBeast, len(matches))
+	for i, m := range matches {
+		filtered[i] = beasts[m.Index]
 	}
 
 	return filtered, nil
 }
 
-func containsIgnoreCase(str, substr string) bool {
-	return len(str) >= len(substr) && (str == substr || len(str) > 0 && (containsIgnoreCase(str[1:], substr) || str[:len(substr)] == substr))
+// TextSearchBeasts mencari di name, abilities dan personality, urut berdasarkan relevansi
+func (s *service) TextSearchBeasts(ctx context.Context, q string, limit int, fields ...string) ([]models.TailedBeastHit, error) {
+	if q == "" {
+		return nil, errors.New("q query parameter is required")
+	}
+
+	hits, err := s.repo.TextSearch(ctx, q, int64(limit), fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hits) == 0 {
+		return nil, errors.New("no tailed beasts found")
+	}
+
+	terms := search.Terms(q)
+	for i := range hits {
+		b := hits[i].TailedBeast
+		var highlights []models.Highlight
+		if h, ok := search.Highlight("name", b.Name, terms); ok {
+			highlights = append(highlights, h)
+		}
+		highlights = append(highlights, search.HighlightAll("abilities", b.Abilities, terms)...)
+		highlights = append(highlights, search.HighlightAll("personality", []string{b.Personality}, terms)...)
+		hits[i].Highlights = highlights
+	}
+
+	return hits, nil
+}
+
+// BeastFacets menghitung bucket per facet untuk dokumen dalam scope
+func (s *service) BeastFacets(ctx context.Context, facets []string, scope models.FacetScope) (models.Facets, error) {
+	if len(facets) == 0 {
+		return nil, errors.New("invalid facets: at least one facet is required")
+	}
+	for _, name := range facets {
+		if _, ok := FacetFields[name]; !ok {
+			return nil, fmt.Errorf("invalid facet %s", name)
+		}
+	}
+
+	filter, err := buildFilter(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Facets(ctx, filter, facets)
+}
+
+// BeastStats mengambil statistik dashboard; groupBy memakai nama facet (rank)
+func (s *service) BeastStats(ctx context.Context, groupBy string) (*models.TailedBeastStats, error) {
+	var field string
+	if groupBy != "" {
+		var ok bool
+		if field, ok = FacetFields[groupBy]; !ok {
+			return nil, fmt.Errorf("invalid groupBy %s", groupBy)
+		}
+	}
+
+	stats, err := s.repo.Stats(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+	stats.GroupBy = groupBy
+	return stats, nil
+}
+
+// ReslugBeasts menghitung ulang slug dari nama untuk tailed beast yang slug-nya tidak sesuai
+func (s *service) ReslugBeasts(ctx context.Context) (int, error) {
+	beasts, err := s.repo.ListBeasts(ctx, bson.M{}, 0, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, b := range beasts {
+		newSlug := slug.Make(b.Name)
+		if newSlug == b.Slug {
+			continue
+		}
+		if err := s.repo.UpdateBySlug(ctx, b.Slug, map[string]interface{}{"slug": newSlug}); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// buildFilter menerjemahkan FacetScope menjadi filter MongoDB
+func buildFilter(scope models.FacetScope) (bson.M, error) {
+	filter := bson.M{}
+	for name, value := range scope.Filters {
+		field, ok := FacetFields[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid filter %s", name)
+		}
+		filter[field] = value
+	}
+	if scope.Slugs != nil {
+		filter["slug"] = bson.M{"$in": scope.Slugs}
+	}
+	if scope.Text != "" {
+		filter["$text"] = bson.M{"$search": scope.Text}
+	}
+	return filter, nil
 }
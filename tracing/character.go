@@ -0,0 +1,167 @@
+package tracing
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"my-gin-app/character"
+	"my-gin-app/database"
+	"my-gin-app/models"
+)
+
+// characterService membungkus character.Service supaya setiap pemanggilan service punya span sendiri
+type characterService struct {
+	character.Service
+}
+
+// CharacterService mengembalikan character.Service yang membuka span per method
+func CharacterService(inner character.Service) character.Service {
+	return &characterService{
+		Service: inner,
+	}
+}
+
+// characterRepository membungkus character.Repository; span command MongoDB menjadi anak span ini
+type characterRepository struct {
+	character.Repository
+}
+
+// CharacterRepository mengembalikan character.Repository yang membuka span per method
+func CharacterRepository(inner character.Repository) character.Repository {
+	return &characterRepository{
+		Repository: inner,
+	}
+}
+
+func (s *characterService) CreateCharacter(ctx context.Context, character *models.Character) (err error) {
+	ctx, span := start(ctx, "character.Service/CreateCharacter")
+	defer end(span, &err)
+	return s.Service.CreateCharacter(ctx, character)
+}
+
+func (s *characterService) GetCharacterBySlug(ctx context.Context, slug string, fields ...string) (result *models.Character, err error) {
+	ctx, span := start(ctx, "character.Service/GetCharacterBySlug")
+	defer end(span, &err)
+	return s.Service.GetCharacterBySlug(ctx, slug, fields...)
+}
+
+func (s *characterService) GetCharactersBySlugs(ctx context.Context, slugs []string) (result []models.Character, err error) {
+	ctx, span := start(ctx, "character.Service/GetCharactersBySlugs")
+	defer end(span, &err)
+	return s.Service.GetCharactersBySlugs(ctx, slugs)
+}
+
+func (s *characterService) UpdateCharacter(ctx context.Context, slug string, updatedData *models.Character) (err error) {
+	ctx, span := start(ctx, "character.Service/UpdateCharacter")
+	defer end(span, &err)
+	return s.Service.UpdateCharacter(ctx, slug, updatedData)
+}
+
+func (s *characterService) DeleteCharacter(ctx context.Context, slug string) (err error) {
+	ctx, span := start(ctx, "character.Service/DeleteCharacter")
+	defer end(span, &err)
+	return s.Service.DeleteCharacter(ctx, slug)
+}
+
+func (s *characterService) ListCharacters(ctx context.Context, page int, limit int, filters map[string]string, fields ...string) (result []models.Character, count int64, err error) {
+	ctx, span := start(ctx, "character.Service/ListCharacters")
+	defer end(span, &err)
+	return s.Service.ListCharacters(ctx, page, limit, filters, fields...)
+}
+
+func (s *characterService) CharacterFacets(ctx context.Context, facets []string, scope models.FacetScope) (result models.Facets, err error) {
+	ctx, span := start(ctx, "character.Service/CharacterFacets")
+	defer end(span, &err)
+	return s.Service.CharacterFacets(ctx, facets, scope)
+}
+
+func (s *characterService) CharacterStats(ctx context.Context, top int, groupBy string) (result *models.CharacterStats, err error) {
+	ctx, span := start(ctx, "character.Service/CharacterStats")
+	defer end(span, &err)
+	return s.Service.CharacterStats(ctx, top, groupBy)
+}
+
+func (s *characterService) SearchCharacters(ctx context.Context, name string, fields ...string) (result []models.Character, err error) {
+	ctx, span := start(ctx, "character.Service/SearchCharacters")
+	defer end(span, &err)
+	return s.Service.SearchCharacters(ctx, name, fields...)
+}
+
+func (s *characterService) TextSearchCharacters(ctx context.Context, q string, limit int, fields ...string) (result []models.CharacterHit, err error) {
+	ctx, span := start(ctx, "character.Service/TextSearchCharacters")
+	defer end(span, &err)
+	return s.Service.TextSearchCharacters(ctx, q, limit, fields...)
+}
+
+func (s *characterService) ReslugCharacters(ctx context.Context) (result int, err error) {
+	ctx, span := start(ctx, "character.Service/ReslugCharacters")
+	defer end(span, &err)
+	return s.Service.ReslugCharacters(ctx)
+}
+
+func (r *characterRepository) Create(ctx context.Context, character *models.Character) (err error) {
+	ctx, span := start(ctx, "character.Repository/Create")
+	defer end(span, &err)
+	return r.Repository.Create(ctx, character)
+}
+
+func (r *characterRepository) FindBySlug(ctx context.Context, slug string, fields ...string) (result *models.Character, err error) {
+	ctx, span := start(ctx, "character.Repository/FindBySlug")
+	defer end(span, &err)
+	return r.Repository.FindBySlug(ctx, slug, fields...)
+}
+
+func (r *characterRepository) UpdateBySlug(ctx context.Context, slug string, update bson.M) (err error) {
+	ctx, span := start(ctx, "character.Repository/UpdateBySlug")
+	defer end(span, &err)
+	return r.Repository.UpdateBySlug(ctx, slug, update)
+}
+
+func (r *characterRepository) DeleteBySlug(ctx context.Context, slug string) (err error) {
+	ctx, span := start(ctx, "character.Repository/DeleteBySlug")
+	defer end(span, &err)
+	return r.Repository.DeleteBySlug(ctx, slug)
+}
+
+func (r *characterRepository) ListCharacters(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) (result []models.Character, err error) {
+	ctx, span := start(ctx, "character.Repository/ListCharacters")
+	defer end(span, &err)
+	return r.Repository.ListCharacters(ctx, filter, skip, limit, fields...)
+}
+
+func (r *characterRepository) CountCharacters(ctx context.Context, filter bson.M) (result int64, err error) {
+	ctx, span := start(ctx, "character.Repository/CountCharacters")
+	defer end(span, &err)
+	return r.Repository.CountCharacters(ctx, filter)
+}
+
+func (r *characterRepository) Facets(ctx context.Context, filter bson.M, facets []string) (result models.Facets, err error) {
+	ctx, span := start(ctx, "character.Repository/Facets")
+	defer end(span, &err)
+	return r.Repository.Facets(ctx, filter, facets)
+}
+
+func (r *characterRepository) Stats(ctx context.Context, top int64, groupBy string) (result *models.CharacterStats, err error) {
+	ctx, span := start(ctx, "character.Repository/Stats")
+	defer end(span, &err)
+	return r.Repository.Stats(ctx, top, groupBy)
+}
+
+func (r *characterRepository) TextSearch(ctx context.Context, query string, limit int64, fields ...string) (result []models.CharacterHit, err error) {
+	ctx, span := start(ctx, "character.Repository/TextSearch")
+	defer end(span, &err)
+	return r.Repository.TextSearch(ctx, query, limit, fields...)
+}
+
+func (r *characterRepository) EnsureIndexes(ctx context.Context) (result []database.IndexStatus, err error) {
+	ctx, span := start(ctx, "character.Repository/EnsureIndexes")
+	defer end(span, &err)
+	return r.Repository.EnsureIndexes(ctx)
+}
+
+func (r *characterRepository) IndexStatus(ctx context.Context) (result []database.IndexStatus, err error) {
+	ctx, span := start(ctx, "character.Repository/IndexStatus")
+	defer end(span, &err)
+	return r.Repository.IndexStatus(ctx)
+}
@@ -0,0 +1,173 @@
+package tracing
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"my-gin-app/database"
+	"my-gin-app/models"
+	"my-gin-app/tailedbeast"
+)
+
+// beastService membungkus tailedbeast.Service supaya setiap pemanggilan service punya span sendiri
+type beastService struct {
+	tailedbeast.Service
+}
+
+// BeastService mengembalikan tailedbeast.Service yang membuka span per method
+func BeastService(inner tailedbeast.Service) tailedbeast.Service {
+	return &beastService{
+		Service: inner,
+	}
+}
+
+// beastRepository membungkus tailedbeast.Repository; span command MongoDB menjadi anak span ini
+type beastRepository struct {
+	tailedbeast.Repository
+}
+
+// BeastRepository mengembalikan tailedbeast.Repository yang membuka span per method
+func BeastRepository(inner tailedbeast.Repository) tailedbeast.Repository {
+	return &beastRepository{
+		Repository: inner,
+	}
+}
+
+func (s *beastService) CreateBeast(ctx context.Context, beast *models.TailedBeast) (err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/CreateBeast")
+	defer end(span, &err)
+	return s.Service.CreateBeast(ctx, beast)
+}
+
+func (s *beastService) GetBeastBySlug(ctx context.Context, slug string, fields ...string) (result *models.TailedBeast, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/GetBeastBySlug")
+	defer end(span, &err)
+	return s.Service.GetBeastBySlug(ctx, slug, fields...)
+}
+
+func (s *beastService) GetBeastsBySlugs(ctx context.Context, slugs []string) (result []models.TailedBeast, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/GetBeastsBySlugs")
+	defer end(span, &err)
+	return s.Service.GetBeastsBySlugs(ctx, slugs)
+}
+
+func (s *beastService) GetBeastsByJinchuriki(ctx context.Context, characterSlugs []string) (result []models.TailedBeast, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/GetBeastsByJinchuriki")
+	defer end(span, &err)
+	return s.Service.GetBeastsByJinchuriki(ctx, characterSlugs)
+}
+
+func (s *beastService) UpdateBeast(ctx context.Context, slug string, updatedData *models.TailedBeast) (err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/UpdateBeast")
+	defer end(span, &err)
+	return s.Service.UpdateBeast(ctx, slug, updatedData)
+}
+
+func (s *beastService) DeleteBeast(ctx context.Context, slug string) (err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/DeleteBeast")
+	defer end(span, &err)
+	return s.Service.DeleteBeast(ctx, slug)
+}
+
+func (s *beastService) ListBeasts(ctx context.Context, page int, limit int, filters map[string]string, fields ...string) (result []models.TailedBeast, count int64, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/ListBeasts")
+	defer end(span, &err)
+	return s.Service.ListBeasts(ctx, page, limit, filters, fields...)
+}
+
+func (s *beastService) BeastFacets(ctx context.Context, facets []string, scope models.FacetScope) (result models.Facets, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/BeastFacets")
+	defer end(span, &err)
+	return s.Service.BeastFacets(ctx, facets, scope)
+}
+
+func (s *beastService) BeastStats(ctx context.Context, groupBy string) (result *models.TailedBeastStats, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/BeastStats")
+	defer end(span, &err)
+	return s.Service.BeastStats(ctx, groupBy)
+}
+
+func (s *beastService) SearchBeasts(ctx context.Context, name string, fields ...string) (result []models.TailedBeast, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/SearchBeasts")
+	defer end(span, &err)
+	return s.Service.SearchBeasts(ctx, name, fields...)
+}
+
+func (s *beastService) TextSearchBeasts(ctx context.Context, q string, limit int, fields ...string) (result []models.TailedBeastHit, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/TextSearchBeasts")
+	defer end(span, &err)
+	return s.Service.TextSearchBeasts(ctx, q, limit, fields...)
+}
+
+func (s *beastService) ReslugBeasts(ctx context.Context) (result int, err error) {
+	ctx, span := start(ctx, "tailedbeast.Service/ReslugBeasts")
+	defer end(span, &err)
+	return s.Service.ReslugBeasts(ctx)
+}
+
+func (r *beastRepository) Create(ctx context.Context, beast *models.TailedBeast) (err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/Create")
+	defer end(span, &err)
+	return r.Repository.Create(ctx, beast)
+}
+
+func (r *beastRepository) FindBySlug(ctx context.Context, slug string, fields ...string) (result *models.TailedBeast, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/FindBySlug")
+	defer end(span, &err)
+	return r.Repository.FindBySlug(ctx, slug, fields...)
+}
+
+func (r *beastRepository) UpdateBySlug(ctx context.Context, slug string, update bson.M) (err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/UpdateBySlug")
+	defer end(span, &err)
+	return r.Repository.UpdateBySlug(ctx, slug, update)
+}
+
+func (r *beastRepository) DeleteBySlug(ctx context.Context, slug string) (err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/DeleteBySlug")
+	defer end(span, &err)
+	return r.Repository.DeleteBySlug(ctx, slug)
+}
+
+func (r *beastRepository) ListBeasts(ctx context.Context, filter bson.M, skip int64, limit int64, fields ...string) (result []models.TailedBeast, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/ListBeasts")
+	defer end(span, &err)
+	return r.Repository.ListBeasts(ctx, filter, skip, limit, fields...)
+}
+
+func (r *beastRepository) CountBeasts(ctx context.Context, filter bson.M) (result int64, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/CountBeasts")
+	defer end(span, &err)
+	return r.Repository.CountBeasts(ctx, filter)
+}
+
+func (r *beastRepository) Facets(ctx context.Context, filter bson.M, facets []string) (result models.Facets, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/Facets")
+	defer end(span, &err)
+	return r.Repository.Facets(ctx, filter, facets)
+}
+
+func (r *beastRepository) Stats(ctx context.Context, groupBy string) (result *models.TailedBeastStats, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/Stats")
+	defer end(span, &err)
+	return r.Repository.Stats(ctx, groupBy)
+}
+
+func (r *beastRepository) TextSearch(ctx context.Context, query string, limit int64, fields ...string) (result []models.TailedBeastHit, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/TextSearch")
+	defer end(span, &err)
+	return r.Repository.TextSearch(ctx, query, limit, fields...)
+}
+
+func (r *beastRepository) EnsureIndexes(ctx context.Context) (result []database.IndexStatus, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/EnsureIndexes")
+	defer end(span, &err)
+	return r.Repository.EnsureIndexes(ctx)
+}
+
+func (r *beastRepository) IndexStatus(ctx context.Context) (result []database.IndexStatus, err error) {
+	ctx, span := start(ctx, "tailedbeast.Repository/IndexStatus")
+	defer end(span, &err)
+	return r.Repository.IndexStatus(ctx)
+}
@@ -0,0 +1,80 @@
+// Package tracing memasang OpenTelemetry: propagasi W3C traceparent, exporter OTLP yang diatur lewat env
+// dan span untuk service serta repository. Tanpa konfigurasi exporter, tracer tetap no-op.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceName dipakai jika OTEL_SERVICE_NAME kosong
+const ServiceName = "naruto-api"
+
+const instrumentationName = "my-gin-app"
+
+// Setup memasang propagator W3C (traceparent dan baggage) dan, jika OTEL_EXPORTER_OTLP_ENDPOINT atau
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT diisi, tracer provider dengan exporter OTLP. Protokol dipilih lewat
+// OTEL_EXPORTER_OTLP_PROTOCOL (grpc atau http/protobuf); variabel OTEL_* lain dibaca langsung oleh exporter.
+// shutdown mengirim span yang tersisa dan harus dipanggil saat aplikasi berhenti.
+func Setup(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	switch protocol := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); protocol {
+	case "", "grpc":
+		exporter, err = otlptracegrpc.New(ctx)
+	case "http/protobuf":
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported OTEL_EXPORTER_OTLP_PROTOCOL %q", protocol)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// OTEL_SERVICE_NAME dan OTEL_RESOURCE_ATTRIBUTES menimpa nama default
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(ServiceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// start membuka span anak; tracer diambil setiap kali supaya provider dari Setup selalu dipakai
+func start(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name)
+}
+
+// end dipanggil lewat defer dari decorator; err menunjuk named result method
+func end(span trace.Span, err *error) {
+	if *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}